@@ -9,8 +9,10 @@ import (
 	"os/signal"
 	"syscall"
 
+	authdb "grpc-messenger-core/db/auth"
 	"grpc-messenger-core/db/postgres"
 	"grpc-messenger-core/internal/auth"
+	"grpc-messenger-core/internal/middleware"
 	pb "grpc-messenger-core/proto/auth"
 
 	"google.golang.org/grpc"
@@ -18,7 +20,9 @@ import (
 )
 
 var (
-	port = flag.Int("port", 50051, "The server port")
+	port       = flag.Int("port", 50051, "The server port")
+	configPath = flag.String("config", "config/auth-service/config.toml", "Path to the config file")
+	devMode    = flag.Bool("dev-mode", false, "Serve mock responses when no database is available (development only)")
 )
 
 func main() {
@@ -28,11 +32,22 @@ func main() {
 	logger := log.New(os.Stdout, "[AUTH-SERVICE] ", log.LstdFlags)
 	logger.Println("Starting Auth Service...")
 
+	// Load JWT keys
+	keys, err := middleware.LoadKeySet(*configPath)
+	if err != nil {
+		logger.Fatalf("Failed to load JWT keys: %v", err)
+	}
+	middleware.SetKeySet(keys)
+
 	// Connect to database
 	db, err := postgres.NewPostgresDB()
 	if err != nil {
 		logger.Printf("Warning: Failed to connect to database: %v", err)
-		logger.Println("Continuing without database connection for testing purposes...")
+		if *devMode {
+			logger.Println("Continuing without database connection in development mode...")
+		} else {
+			logger.Println("Continuing without database connection; auth requests will fail (use --dev-mode for mock responses)")
+		}
 	} else {
 		defer db.Close()
 	}
@@ -43,14 +58,43 @@ func main() {
 		logger.Fatalf("Failed to listen: %v", err)
 	}
 
+	// Create the token revocation cache
+	var revocations *middleware.RevocationChecker
+	if db != nil {
+		revocations = middleware.NewRevocationChecker(authdb.NewRepository(db), middleware.DefaultRevocationCacheTTL)
+	}
+
+	// Create the auth interceptor; registration, login and key discovery are public
+	publicMethods := append([]string{
+		pb.AuthService_Register_FullMethodName,
+		pb.AuthService_Login_FullMethodName,
+		pb.AuthService_RefreshToken_FullMethodName,
+		pb.AuthService_ValidateToken_FullMethodName,
+		pb.AuthService_GetJWKS_FullMethodName,
+	}, middleware.ReflectionMethods...)
+	authenticator := middleware.NewAuthenticator(logger, revocations, publicMethods...)
+	authenticator.RequireRole(middleware.RoleAdmin, "/auth.AdminService/")
+	authenticator.RequireRole(middleware.RoleModerator,
+		pb.AdminService_ListUsers_FullMethodName,
+		pb.AdminService_DisableUser_FullMethodName,
+		pb.AdminService_EnableUser_FullMethodName,
+	)
+
 	// Create gRPC server
-	s := grpc.NewServer()
+	s := grpc.NewServer(
+		grpc.UnaryInterceptor(authenticator.UnaryInterceptor()),
+		grpc.StreamInterceptor(authenticator.StreamInterceptor()),
+	)
 
 	// Create auth service
-	authService := auth.NewAuthService(db, logger)
+	authService := auth.NewAuthService(db, logger, *devMode, revocations)
+
+	// Create admin service
+	adminService := auth.NewAdminService(db, logger, revocations)
 
-	// Register service
+	// Register services
 	pb.RegisterAuthServiceServer(s, authService)
+	pb.RegisterAdminServiceServer(s, adminService)
 
 	// Register reflection service for development tools
 	reflection.Register(s)
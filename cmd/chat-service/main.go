@@ -1,6 +1,7 @@
 package main
 
 import (
+	"context"
 	"flag"
 	"fmt"
 	"log"
@@ -9,8 +10,10 @@ import (
 	"os/signal"
 	"syscall"
 
+	authdb "grpc-messenger-core/db/auth"
 	"grpc-messenger-core/db/postgres"
 	"grpc-messenger-core/internal/chat"
+	"grpc-messenger-core/internal/middleware"
 	pb "grpc-messenger-core/proto/chat"
 
 	"google.golang.org/grpc"
@@ -18,7 +21,8 @@ import (
 )
 
 var (
-	port = flag.Int("port", 50052, "The server port")
+	port       = flag.Int("port", 50052, "The server port")
+	configPath = flag.String("config", "config/chat-service/config.toml", "Path to the config file")
 )
 
 func main() {
@@ -28,6 +32,13 @@ func main() {
 	logger := log.New(os.Stdout, "[CHAT-SERVICE] ", log.LstdFlags)
 	logger.Println("Starting Chat Service...")
 
+	// Load JWT keys
+	keys, err := middleware.LoadKeySet(*configPath)
+	if err != nil {
+		logger.Fatalf("Failed to load JWT keys: %v", err)
+	}
+	middleware.SetKeySet(keys)
+
 	// Connect to database
 	db, err := postgres.NewPostgresDB()
 	if err != nil {
@@ -43,11 +54,46 @@ func main() {
 		logger.Fatalf("Failed to listen: %v", err)
 	}
 
+	// Create the token revocation cache
+	var revocations *middleware.RevocationChecker
+	if db != nil {
+		revocations = middleware.NewRevocationChecker(authdb.NewRepository(db), middleware.DefaultRevocationCacheTTL)
+	}
+
+	// Create the auth interceptor
+	authenticator := middleware.NewAuthenticator(logger, revocations, middleware.ReflectionMethods...)
+	authenticator.RequireRole(middleware.RoleAdmin, pb.ChatService_GetStreamStats_FullMethodName)
+
 	// Create gRPC server
-	s := grpc.NewServer()
+	s := grpc.NewServer(
+		grpc.UnaryInterceptor(authenticator.UnaryInterceptor()),
+		grpc.StreamInterceptor(authenticator.StreamInterceptor()),
+	)
+
+	// Load chat settings
+	config, err := chat.LoadConfig(*configPath)
+	if err != nil {
+		logger.Fatalf("Failed to load chat config: %v", err)
+	}
 
 	// Create chat service
-	chatService := chat.NewChatService(db, logger)
+	chatService := chat.NewChatService(db, logger, config)
+
+	// Close streams of users removed from rooms
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	if db != nil {
+		if err := chatService.ListenRoomEvents(ctx, postgres.ConnString()); err != nil {
+			logger.Printf("Warning: Failed to listen for room events: %v", err)
+		}
+	}
+
+	// Share room events with the other chat service instances
+	if db != nil && config.Broadcaster == chat.BroadcasterPostgres {
+		if err := chatService.UsePostgresBroadcaster(ctx, postgres.ConnString()); err != nil {
+			logger.Printf("Warning: Failed to listen for broadcast events, streams will only see events of this instance: %v", err)
+		}
+	}
 
 	// Register service
 	pb.RegisterChatServiceServer(s, chatService)
@@ -52,6 +52,12 @@ func main() {
 		logger.Fatalf("Failed to register auth service handler: %v", err)
 	}
 
+	// Register Admin service, served by the auth service
+	err = authpb.RegisterAdminServiceHandlerFromEndpoint(ctx, mux, *authServiceAddr, opts)
+	if err != nil {
+		logger.Fatalf("Failed to register admin service handler: %v", err)
+	}
+
 	// Register Chat service
 	err = chatpb.RegisterChatServiceHandlerFromEndpoint(ctx, mux, *chatServiceAddr, opts)
 	if err != nil {
@@ -1,6 +1,7 @@
 package main
 
 import (
+	"context"
 	"flag"
 	"fmt"
 	"log"
@@ -9,7 +10,9 @@ import (
 	"os/signal"
 	"syscall"
 
+	authdb "grpc-messenger-core/db/auth"
 	"grpc-messenger-core/db/postgres"
+	"grpc-messenger-core/internal/middleware"
 	"grpc-messenger-core/internal/room"
 	pb "grpc-messenger-core/proto/room"
 
@@ -18,7 +21,8 @@ import (
 )
 
 var (
-	port = flag.Int("port", 50053, "The server port")
+	port       = flag.Int("port", 50053, "The server port")
+	configPath = flag.String("config", "config/room-service/config.toml", "Path to the config file")
 )
 
 func main() {
@@ -28,6 +32,13 @@ func main() {
 	logger := log.New(os.Stdout, "[ROOM-SERVICE] ", log.LstdFlags)
 	logger.Println("Starting Room Service...")
 
+	// Load JWT keys
+	keys, err := middleware.LoadKeySet(*configPath)
+	if err != nil {
+		logger.Fatalf("Failed to load JWT keys: %v", err)
+	}
+	middleware.SetKeySet(keys)
+
 	// Connect to database
 	db, err := postgres.NewPostgresDB()
 	if err != nil {
@@ -43,11 +54,36 @@ func main() {
 		logger.Fatalf("Failed to listen: %v", err)
 	}
 
+	// Create the token revocation cache
+	var revocations *middleware.RevocationChecker
+	if db != nil {
+		revocations = middleware.NewRevocationChecker(authdb.NewRepository(db), middleware.DefaultRevocationCacheTTL)
+	}
+
+	// Create the auth interceptor
+	authenticator := middleware.NewAuthenticator(logger, revocations, middleware.ReflectionMethods...)
+
 	// Create gRPC server
-	s := grpc.NewServer()
+	s := grpc.NewServer(
+		grpc.UnaryInterceptor(authenticator.UnaryInterceptor()),
+		grpc.StreamInterceptor(authenticator.StreamInterceptor()),
+	)
+
+	// Load room settings
+	config, err := room.LoadConfig(*configPath)
+	if err != nil {
+		logger.Fatalf("Failed to load room config: %v", err)
+	}
 
 	// Create room service
-	roomService := room.NewRoomService(db, logger)
+	roomService := room.NewRoomService(db, logger, config)
+
+	// Purge deleted rooms once their retention has passed
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	if db != nil {
+		go roomService.PurgeDeletedRooms(ctx)
+	}
 
 	// Register service
 	pb.RegisterRoomServiceServer(s, roomService)
@@ -7,6 +7,7 @@ import (
 	"time"
 
 	"github.com/golang-jwt/jwt/v5"
+	"github.com/spf13/viper"
 	"golang.org/x/crypto/bcrypt"
 )
 
@@ -18,8 +19,16 @@ type User struct {
 	Password string
 }
 
-// JWT secret key - in production, this should be stored securely
-const jwtSecret = "your-secret-key-change-this-in-production"
+// jwtSecret returns the JWT secret from the [jwt] section of the config file
+// loaded by LoadConfig, or the JWT_SECRET environment variable
+func jwtSecret() ([]byte, error) {
+	viper.BindEnv("jwt.secret", "JWT_SECRET")
+	secret := viper.GetString("jwt.secret")
+	if secret == "" {
+		return nil, errors.New("JWT secret is not configured")
+	}
+	return []byte(secret), nil
+}
 
 // CreateUsersTable creates the users table if it doesn't exist
 func CreateUsersTable(db *sql.DB) error {
@@ -111,7 +120,12 @@ func AuthenticateUser(db *sql.DB, username, password string) (string, int64, err
 		"exp":      time.Now().Add(time.Hour * 24).Unix(), // Token expires in 24 hours
 	})
 
-	tokenString, err := token.SignedString([]byte(jwtSecret))
+	secret, err := jwtSecret()
+	if err != nil {
+		return "", 0, err
+	}
+
+	tokenString, err := token.SignedString(secret)
 	if err != nil {
 		return "", 0, fmt.Errorf("error signing token: %w", err)
 	}
@@ -126,7 +140,7 @@ func ValidateToken(tokenString string) (int64, string, error) {
 		if _, ok := token.Method.(*jwt.SigningMethodHMAC); !ok {
 			return nil, fmt.Errorf("unexpected signing method: %v", token.Header["alg"])
 		}
-		return []byte(jwtSecret), nil
+		return jwtSecret()
 	})
 
 	if err != nil {
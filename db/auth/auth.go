@@ -3,6 +3,18 @@ package auth
 import (
 	"context"
 	"database/sql"
+	"errors"
+	"time"
+
+	"grpc-messenger-core/db/postgres"
+)
+
+// Refresh token errors
+var (
+	ErrRefreshTokenNotFound = errors.New("refresh token not found")
+	ErrRefreshTokenExpired  = errors.New("refresh token has expired")
+	ErrRefreshTokenRevoked  = errors.New("refresh token has been revoked")
+	ErrRefreshTokenReused   = errors.New("refresh token has already been used")
 )
 
 // User represents a user in the database
@@ -10,6 +22,34 @@ type User struct {
 	ID           int64
 	Username     string
 	PasswordHash string
+	Role         string
+	Disabled     bool
+	TokenVersion int64
+	CreatedAt    time.Time
+}
+
+// userColumns are the columns scanned by scanUser
+const userColumns = `id, username, password_hash, role, disabled, token_version, created_at`
+
+// rowScanner is implemented by *sql.Row and *sql.Rows
+type rowScanner interface {
+	Scan(dest ...interface{}) error
+}
+
+// scanUser scans a row of userColumns into a User
+func scanUser(row rowScanner) (*User, error) {
+	user := &User{}
+	err := row.Scan(&user.ID, &user.Username, &user.PasswordHash, &user.Role, &user.Disabled, &user.TokenVersion, &user.CreatedAt)
+	return user, err
+}
+
+// RefreshToken represents a stored refresh token. Tokens issued by rotating
+// another token share its FamilyID.
+type RefreshToken struct {
+	ID        int64
+	UserID    int64
+	FamilyID  string
+	ExpiresAt time.Time
 }
 
 // Repository handles database operations for auth
@@ -40,16 +80,207 @@ func (r *Repository) CreateUser(ctx context.Context, username, passwordHash stri
 
 // GetUserByUsername retrieves a user by username
 func (r *Repository) GetUserByUsername(ctx context.Context, username string) (*User, error) {
-	user := &User{}
-	query := `SELECT id, username, password_hash FROM users WHERE username = $1`
-	err := r.db.QueryRowContext(ctx, query, username).Scan(&user.ID, &user.Username, &user.PasswordHash)
-	return user, err
+	query := `SELECT ` + userColumns + ` FROM users WHERE username = $1`
+	return scanUser(r.db.QueryRowContext(ctx, query, username))
 }
 
 // GetUserByID retrieves a user by ID
 func (r *Repository) GetUserByID(ctx context.Context, userID int64) (*User, error) {
-	user := &User{}
-	query := `SELECT id, username, password_hash FROM users WHERE id = $1`
-	err := r.db.QueryRowContext(ctx, query, userID).Scan(&user.ID, &user.Username, &user.PasswordHash)
-	return user, err
+	query := `SELECT ` + userColumns + ` FROM users WHERE id = $1`
+	return scanUser(r.db.QueryRowContext(ctx, query, userID))
+}
+
+// ListUsers retrieves users whose username contains query, ordered by ID
+func (r *Repository) ListUsers(ctx context.Context, query string, limit, offset int64) ([]User, error) {
+	rows, err := r.db.QueryContext(
+		ctx,
+		`SELECT `+userColumns+` FROM users WHERE username ILIKE '%' || $1 || '%' ESCAPE '\' ORDER BY id LIMIT $2 OFFSET $3`,
+		postgres.EscapeLike(query), limit, offset,
+	)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var users []User
+	for rows.Next() {
+		user, err := scanUser(rows)
+		if err != nil {
+			return nil, err
+		}
+		users = append(users, *user)
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+
+	return users, nil
+}
+
+// SetUserRole changes a user's role
+func (r *Repository) SetUserRole(ctx context.Context, userID int64, role string) error {
+	query := `UPDATE users SET role = $2 WHERE id = $1`
+	_, err := r.db.ExecContext(ctx, query, userID, role)
+	return err
+}
+
+// SetUserDisabled disables or re-enables a user account
+func (r *Repository) SetUserDisabled(ctx context.Context, userID int64, disabled bool) error {
+	query := `UPDATE users SET disabled = $2 WHERE id = $1`
+	_, err := r.db.ExecContext(ctx, query, userID, disabled)
+	return err
+}
+
+// UpdatePasswordHash sets a new password hash for a user
+func (r *Repository) UpdatePasswordHash(ctx context.Context, userID int64, passwordHash string) error {
+	query := `UPDATE users SET password_hash = $2 WHERE id = $1`
+	_, err := r.db.ExecContext(ctx, query, userID, passwordHash)
+	return err
+}
+
+// CreateRefreshToken stores the hash of a new refresh token
+func (r *Repository) CreateRefreshToken(ctx context.Context, userID int64, tokenHash, familyID string, expiresAt time.Time) error {
+	query := `INSERT INTO refresh_tokens (user_id, token_hash, family_id, expires_at) VALUES ($1, $2, $3, $4)`
+	_, err := r.db.ExecContext(ctx, query, userID, tokenHash, familyID, expiresAt)
+	return err
+}
+
+// RotateRefreshToken marks a refresh token as used and stores its replacement
+// in the same family. If the token was already used, the whole family is
+// revoked and ErrRefreshTokenReused is returned.
+func (r *Repository) RotateRefreshToken(ctx context.Context, tokenHash, newTokenHash string, expiresAt time.Time) (*RefreshToken, error) {
+	tx, err := r.db.BeginTx(ctx, nil)
+	if err != nil {
+		return nil, err
+	}
+	defer tx.Rollback()
+
+	token := &RefreshToken{}
+	var usedAt, revokedAt sql.NullTime
+	err = tx.QueryRowContext(
+		ctx,
+		`SELECT id, user_id, family_id, expires_at, used_at, revoked_at FROM refresh_tokens WHERE token_hash = $1 FOR UPDATE`,
+		tokenHash,
+	).Scan(&token.ID, &token.UserID, &token.FamilyID, &token.ExpiresAt, &usedAt, &revokedAt)
+	if errors.Is(err, sql.ErrNoRows) {
+		return nil, ErrRefreshTokenNotFound
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	if revokedAt.Valid {
+		return nil, ErrRefreshTokenRevoked
+	}
+
+	// A used token being presented again means it was stolen, so revoke every
+	// token descended from the same login
+	if usedAt.Valid {
+		_, err = tx.ExecContext(
+			ctx,
+			`UPDATE refresh_tokens SET revoked_at = NOW() WHERE family_id = $1 AND revoked_at IS NULL`,
+			token.FamilyID,
+		)
+		if err != nil {
+			return nil, err
+		}
+		if err := tx.Commit(); err != nil {
+			return nil, err
+		}
+		return nil, ErrRefreshTokenReused
+	}
+
+	if time.Now().After(token.ExpiresAt) {
+		return nil, ErrRefreshTokenExpired
+	}
+
+	// Mark the old token as used
+	_, err = tx.ExecContext(ctx, `UPDATE refresh_tokens SET used_at = NOW() WHERE id = $1`, token.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	// Insert the replacement token
+	_, err = tx.ExecContext(
+		ctx,
+		`INSERT INTO refresh_tokens (user_id, token_hash, family_id, expires_at) VALUES ($1, $2, $3, $4)`,
+		token.UserID, newTokenHash, token.FamilyID, expiresAt,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := tx.Commit(); err != nil {
+		return nil, err
+	}
+
+	return token, nil
+}
+
+// RevokeRefreshTokenFamily revokes the family of a user's refresh token
+func (r *Repository) RevokeRefreshTokenFamily(ctx context.Context, userID int64, tokenHash string) error {
+	query := `
+		UPDATE refresh_tokens SET revoked_at = NOW()
+		WHERE revoked_at IS NULL AND family_id = (
+			SELECT family_id FROM refresh_tokens WHERE token_hash = $1 AND user_id = $2
+		)
+	`
+	_, err := r.db.ExecContext(ctx, query, tokenHash, userID)
+	return err
+}
+
+// RevokeToken records the ID of a revoked access token
+func (r *Repository) RevokeToken(ctx context.Context, tokenID string, userID int64, expiresAt time.Time) error {
+	query := `INSERT INTO revoked_tokens (token_id, user_id, expires_at) VALUES ($1, $2, $3) ON CONFLICT DO NOTHING`
+	_, err := r.db.ExecContext(ctx, query, tokenID, userID, expiresAt)
+	return err
+}
+
+// IsTokenRevoked checks if the access token with the given ID was revoked
+func (r *Repository) IsTokenRevoked(ctx context.Context, tokenID string) (bool, error) {
+	var exists bool
+	query := `SELECT EXISTS(SELECT 1 FROM revoked_tokens WHERE token_id = $1)`
+	err := r.db.QueryRowContext(ctx, query, tokenID).Scan(&exists)
+	return exists, err
+}
+
+// GetTokenVersion retrieves a user's current token version
+func (r *Repository) GetTokenVersion(ctx context.Context, userID int64) (int64, error) {
+	var version int64
+	query := `SELECT token_version FROM users WHERE id = $1`
+	err := r.db.QueryRowContext(ctx, query, userID).Scan(&version)
+	return version, err
+}
+
+// RevokeAllUserTokens bumps a user's token version, invalidating every access
+// token issued so far, and revokes all of the user's refresh tokens. It
+// returns the new token version.
+func (r *Repository) RevokeAllUserTokens(ctx context.Context, userID int64) (int64, error) {
+	tx, err := r.db.BeginTx(ctx, nil)
+	if err != nil {
+		return 0, err
+	}
+	defer tx.Rollback()
+
+	var version int64
+	err = tx.QueryRowContext(
+		ctx,
+		`UPDATE users SET token_version = token_version + 1 WHERE id = $1 RETURNING token_version`,
+		userID,
+	).Scan(&version)
+	if err != nil {
+		return 0, err
+	}
+
+	_, err = tx.ExecContext(ctx, `UPDATE refresh_tokens SET revoked_at = NOW() WHERE user_id = $1 AND revoked_at IS NULL`, userID)
+	if err != nil {
+		return 0, err
+	}
+
+	if err := tx.Commit(); err != nil {
+		return 0, err
+	}
+
+	return version, nil
 }
@@ -0,0 +1,141 @@
+package chat
+
+import (
+	"context"
+	"encoding/json"
+	"log"
+	"time"
+
+	"grpc-messenger-core/db/postgres"
+)
+
+// Broadcaster carries room events to the subscribers of every chat service
+// instance. Broadcast hands the event to DeliverRoomEvent on each instance,
+// this one included.
+type Broadcaster interface {
+	Broadcast(event Event)
+}
+
+// MemoryBroadcaster delivers room events within this process only. It is
+// enough when a single chat service instance runs.
+type MemoryBroadcaster struct {
+	repo *Repository
+}
+
+// NewMemoryBroadcaster creates a broadcaster delivering to the subscribers of
+// repo
+func NewMemoryBroadcaster(repo *Repository) *MemoryBroadcaster {
+	return &MemoryBroadcaster{repo: repo}
+}
+
+// Broadcast delivers an event to the subscribers of its room
+func (b *MemoryBroadcaster) Broadcast(event Event) {
+	b.repo.DeliverRoomEvent(event)
+}
+
+// BroadcastChannel is the NOTIFY channel on which PostgresBroadcaster
+// publishes room events
+const BroadcastChannel = "room_broadcast"
+
+// broadcastTimeout bounds how long publishing an event may take
+const broadcastTimeout = 5 * time.Second
+
+// PostgresBroadcaster carries room events between chat service instances
+// with PostgreSQL LISTEN/NOTIFY, so the instances can run behind a load
+// balancer
+type PostgresBroadcaster struct {
+	repo   *Repository
+	logger *log.Logger
+}
+
+// broadcastPayload is a room event as sent on BroadcastChannel. Messages and
+// room details are sent by reference and loaded by the receiving instances,
+// which keeps payloads under the NOTIFY size limit.
+type broadcastPayload struct {
+	Type      EventType `json:"type"`
+	RoomID    int64     `json:"room_id"`
+	Time      time.Time `json:"time"`
+	MessageID int64     `json:"message_id,omitempty"`
+	UserID    int64     `json:"user_id,omitempty"`
+	Username  string    `json:"username,omitempty"`
+	Active    bool      `json:"active,omitempty"`
+	Emoji     string    `json:"emoji,omitempty"`
+}
+
+// NewPostgresBroadcaster creates a broadcaster delivering to the subscribers
+// of repo. Call Listen before using it.
+func NewPostgresBroadcaster(repo *Repository, logger *log.Logger) *PostgresBroadcaster {
+	return &PostgresBroadcaster{repo: repo, logger: logger}
+}
+
+// Listen delivers the events broadcast by every instance until ctx is done
+func (b *PostgresBroadcaster) Listen(ctx context.Context, connStr string) error {
+	return postgres.Listen(ctx, connStr, BroadcastChannel, b.logger, func(data []byte) {
+		var payload broadcastPayload
+		if err := json.Unmarshal(data, &payload); err != nil {
+			b.logger.Printf("Error decoding broadcast event: %v", err)
+			return
+		}
+
+		event, err := b.load(ctx, payload)
+		if err != nil {
+			b.logger.Printf("Error loading broadcast %s event for room %d: %v", payload.Type, payload.RoomID, err)
+			return
+		}
+		b.repo.DeliverRoomEvent(event)
+	})
+}
+
+// Broadcast publishes an event to every instance. Failures are logged, as
+// delivery to subscribers is best effort.
+func (b *PostgresBroadcaster) Broadcast(event Event) {
+	payload := broadcastPayload{
+		Type:     event.Type,
+		RoomID:   event.RoomID,
+		Time:     event.Time,
+		UserID:   event.UserID,
+		Username: event.Username,
+		Active:   event.Active,
+		Emoji:    event.Emoji,
+	}
+	if event.Message != nil {
+		payload.MessageID = event.Message.ID
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), broadcastTimeout)
+	defer cancel()
+
+	if err := postgres.Notify(ctx, b.repo.db, BroadcastChannel, payload); err != nil {
+		b.logger.Printf("Error broadcasting %s event for room %d: %v", event.Type, event.RoomID, err)
+	}
+}
+
+// load rebuilds an event from its payload
+func (b *PostgresBroadcaster) load(ctx context.Context, payload broadcastPayload) (Event, error) {
+	event := Event{
+		Type:     payload.Type,
+		RoomID:   payload.RoomID,
+		Time:     payload.Time,
+		UserID:   payload.UserID,
+		Username: payload.Username,
+		Active:   payload.Active,
+		Emoji:    payload.Emoji,
+	}
+
+	if payload.MessageID != 0 {
+		msg, err := b.repo.GetMessage(ctx, payload.MessageID)
+		if err != nil {
+			return Event{}, err
+		}
+		event.Message = msg
+	}
+	if payload.Type == EventRoomUpdated {
+		info, err := b.repo.GetRoomInfo(ctx, payload.RoomID)
+		if err != nil {
+			return Event{}, err
+		}
+		event.Room = info
+	}
+
+	return event, nil
+}
@@ -4,7 +4,10 @@ import (
 	"context"
 	"database/sql"
 	"sync"
+	"sync/atomic"
 	"time"
+
+	"grpc-messenger-core/db/postgres"
 )
 
 // Message represents a chat message in the database
@@ -15,30 +18,129 @@ type Message struct {
 	RoomID     int64
 	SenderName string
 	Timestamp  time.Time
+	EditedAt   sql.NullTime
+	Deleted    bool
+
+	// First message of the thread the message replies to, or 0
+	ParentID int64
+	// Set on the first message of a thread
+	ReplyCount  int64
+	LastReplyAt sql.NullTime
+
+	// Message quoted inline, or 0
+	ReplyToID int64
+	// Where a forwarded message was first posted. The room is 0 if the
+	// message was not forwarded.
+	ForwardedFromRoomID     int64
+	ForwardedFromSenderID   int64
+	ForwardedFromSenderName string
+
+	// Position of the message in its room. Messages are numbered in the
+	// order they commit, without gaps, so streams resume from it.
+	Seq int64
 }
 
+// messageColumns is the column list scanned by scanMessage, for messages
+// aliased as m left joined with their sender in users aliased as u
+const messageColumns = `m.id, m.content, COALESCE(m.sender_id, 0), m.room_id,
+	COALESCE(u.username, '` + SystemSenderName + `'), m.created_at, m.edited_at, m.deleted_at IS NOT NULL,
+	COALESCE(m.parent_id, 0), m.reply_count, m.last_reply_at, COALESCE(m.reply_to_id, 0),
+	COALESCE(m.forwarded_from_room_id, 0), COALESCE(m.forwarded_from_sender_id, 0),
+	CASE WHEN m.forwarded_from_room_id IS NULL THEN ''
+		ELSE COALESCE((SELECT username FROM users WHERE id = m.forwarded_from_sender_id), '` + SystemSenderName + `') END,
+	COALESCE(m.room_seq, 0)`
+
+// scanMessage scans a row selected with messageColumns
+func scanMessage(row interface{ Scan(...interface{}) error }) (*Message, error) {
+	msg := &Message{}
+	err := row.Scan(&msg.ID, &msg.Content, &msg.SenderID, &msg.RoomID, &msg.SenderName, &msg.Timestamp,
+		&msg.EditedAt, &msg.Deleted, &msg.ParentID, &msg.ReplyCount, &msg.LastReplyAt, &msg.ReplyToID,
+		&msg.ForwardedFromRoomID, &msg.ForwardedFromSenderID, &msg.ForwardedFromSenderName, &msg.Seq)
+	return msg, err
+}
+
+// SystemSenderName is the sender name of messages posted by the system rather
+// than a user. System messages have sender ID 0.
+const SystemSenderName = "System"
+
+// PresenceHeartbeat is how often an open stream refreshes its user's
+// last_seen_at, which marks the user as online
+const PresenceHeartbeat = 30 * time.Second
+
 // Repository handles database operations for chat
 type Repository struct {
 	db *sql.DB
 
 	// For real-time messaging
-	roomSubscriptions     map[int64][]chan Message
+	roomSubscriptions     map[int64][]*Subscription
 	roomSubscriptionMutex sync.RWMutex
+
+	// Counters of events subscriptions missed
+	droppedEvents atomic.Int64
+	overflows     atomic.Int64
+
+	// Carries published events to the subscribers of every instance
+	broadcaster Broadcaster
+
+	// Number of open streams per user in each room on this instance. Other
+	// instances are seen through the room_viewers table.
+	viewers      map[memberKey]int
+	viewersMutex sync.Mutex
+	instanceID   string
+
+	// Streams to close when a user leaves or is removed from a room
+	memberWatches      map[memberKey][]chan MembershipEnd
+	memberWatchesMutex sync.Mutex
+}
+
+// memberKey identifies a user's membership in a room
+type memberKey struct {
+	roomID int64
+	userID int64
 }
 
 // NewRepository creates a new chat repository
 func NewRepository(db *sql.DB) *Repository {
-	return &Repository{
+	r := &Repository{
 		db:                db,
-		roomSubscriptions: make(map[int64][]chan Message),
+		roomSubscriptions: make(map[int64][]*Subscription),
+		viewers:           make(map[memberKey]int),
+		instanceID:        newInstanceID(),
+		memberWatches:     make(map[memberKey][]chan MembershipEnd),
 	}
+	r.broadcaster = NewMemoryBroadcaster(r)
+	return r
+}
+
+// SetBroadcaster replaces the in-memory broadcaster, e.g. with one that
+// reaches other instances. It must be called before events are published.
+func (r *Repository) SetBroadcaster(b Broadcaster) {
+	r.broadcaster = b
+}
+
+// NewMessage is a message to save with SaveMessage
+type NewMessage struct {
+	Content  string
+	SenderID int64
+	RoomID   int64
+
+	// To reply in a thread, its first message or any reply in it
+	ParentID int64
+	// To quote a message of the room inline
+	ReplyToID int64
+	// To forward a message, which replaces Content
+	ForwardOf int64
 }
 
-// SaveMessage saves a message to the database and notifies subscribers
-func (r *Repository) SaveMessage(ctx context.Context, content string, senderID, roomID int64) (int64, error) {
+// SaveMessage saves a message to the database and notifies subscribers. It
+// returns ErrMessageNotFound if the thread replied in is not in the room, or
+// if the message forwarded does not exist or the sender cannot read it, and
+// ErrReplyToNotFound if the message quoted is not in the room.
+func (r *Repository) SaveMessage(ctx context.Context, m NewMessage) (int64, error) {
 	var messageID int64
 	var senderName string
 	var timestamp time.Time
+	var seq int64
 
 	// Start a transaction
 	tx, err := r.db.BeginTx(ctx, nil)
@@ -48,21 +150,59 @@ func (r *Repository) SaveMessage(ctx context.Context, content string, senderID,
 	defer tx.Rollback()
 
 	// Get sender name
-	err = tx.QueryRowContext(ctx, `SELECT username FROM users WHERE id = $1`, senderID).Scan(&senderName)
+	err = tx.QueryRowContext(ctx, `SELECT username FROM users WHERE id = $1`, m.SenderID).Scan(&senderName)
 	if err != nil {
 		return 0, err
 	}
 
+	// Replies to a reply continue the thread of its parent
+	if m.ParentID != 0 {
+		m.ParentID, err = threadOf(ctx, tx, m.ParentID, m.RoomID)
+		if err != nil {
+			return 0, err
+		}
+	}
+
+	// A quoted message must be in the same room
+	if m.ReplyToID != 0 {
+		if err := checkQuoted(ctx, tx, m.ReplyToID, m.RoomID); err != nil {
+			return 0, err
+		}
+	}
+
+	// Copy the forwarded message, which the sender must be able to read
+	var origin forwardOrigin
+	if m.ForwardOf != 0 {
+		origin, err = readForwarded(ctx, tx, m.ForwardOf, m.SenderID)
+		if err != nil {
+			return 0, err
+		}
+		m.Content = origin.content
+	}
+
 	// Insert message
 	err = tx.QueryRowContext(
 		ctx,
-		`INSERT INTO messages (content, sender_id, room_id) VALUES ($1, $2, $3) RETURNING id, created_at`,
-		content, senderID, roomID,
-	).Scan(&messageID, &timestamp)
+		`INSERT INTO messages (content, sender_id, room_id, parent_id, reply_to_id, forwarded_from_room_id, forwarded_from_sender_id)
+		VALUES ($1, $2, $3, NULLIF($4, 0), NULLIF($5, 0), NULLIF($6, 0), NULLIF($7, 0))
+		RETURNING id, created_at, room_seq`,
+		m.Content, m.SenderID, m.RoomID, m.ParentID, m.ReplyToID, origin.roomID, origin.senderID,
+	).Scan(&messageID, &timestamp, &seq)
 	if err != nil {
 		return 0, err
 	}
 
+	// Update the thread's reply count
+	if m.ParentID != 0 {
+		_, err = tx.ExecContext(ctx,
+			`UPDATE messages SET reply_count = reply_count + 1, last_reply_at = $2 WHERE id = $1`,
+			m.ParentID, timestamp,
+		)
+		if err != nil {
+			return 0, err
+		}
+	}
+
 	// Commit transaction
 	if err := tx.Commit(); err != nil {
 		return 0, err
@@ -70,25 +210,75 @@ func (r *Repository) SaveMessage(ctx context.Context, content string, senderID,
 
 	// Notify subscribers
 	message := Message{
-		ID:         messageID,
-		Content:    content,
-		SenderID:   senderID,
-		RoomID:     roomID,
-		SenderName: senderName,
-		Timestamp:  timestamp,
+		ID:                      messageID,
+		Content:                 m.Content,
+		SenderID:                m.SenderID,
+		RoomID:                  m.RoomID,
+		SenderName:              senderName,
+		Timestamp:               timestamp,
+		ParentID:                m.ParentID,
+		ReplyToID:               m.ReplyToID,
+		ForwardedFromRoomID:     origin.roomID,
+		ForwardedFromSenderID:   origin.senderID,
+		ForwardedFromSenderName: origin.senderName,
+		Seq:                     seq,
 	}
-	r.NotifyRoomSubscribers(roomID, message)
+	r.NotifyRoomSubscribers(m.RoomID, message)
 
 	return messageID, nil
 }
 
-// GetRoomMessages retrieves messages from a room
+// SaveSystemMessage saves a message posted by the system rather than a user.
+// Subscribers are notified through the room events channel, so system messages
+// saved by another service reach the streams of every chat service instance.
+func (r *Repository) SaveSystemMessage(ctx context.Context, content string, roomID int64) (int64, error) {
+	tx, err := r.db.BeginTx(ctx, nil)
+	if err != nil {
+		return 0, err
+	}
+	defer tx.Rollback()
+
+	var messageID int64
+	err = tx.QueryRowContext(
+		ctx,
+		`INSERT INTO messages (content, sender_id, room_id) VALUES ($1, NULL, $2) RETURNING id`,
+		content, roomID,
+	).Scan(&messageID)
+	if err != nil {
+		return 0, err
+	}
+
+	err = postgres.NotifyRoomEvent(ctx, tx, postgres.RoomEvent{
+		Type:      postgres.RoomEventMessage,
+		RoomID:    roomID,
+		MessageID: messageID,
+	})
+	if err != nil {
+		return 0, err
+	}
+
+	return messageID, tx.Commit()
+}
+
+// GetMessage retrieves a message by ID. It returns sql.ErrNoRows if the
+// message does not exist.
+func (r *Repository) GetMessage(ctx context.Context, messageID int64) (*Message, error) {
+	query := `
+		SELECT ` + messageColumns + `
+		FROM messages m
+		LEFT JOIN users u ON m.sender_id = u.id
+		WHERE m.id = $1
+	`
+	return scanMessage(r.db.QueryRowContext(ctx, query, messageID))
+}
+
+// GetRoomMessages retrieves messages from a room, leaving out thread replies
 func (r *Repository) GetRoomMessages(ctx context.Context, roomID, limit, offset int64) ([]Message, error) {
 	query := `
-		SELECT m.id, m.content, m.sender_id, m.room_id, u.username, m.created_at
+		SELECT ` + messageColumns + `
 		FROM messages m
-		JOIN users u ON m.sender_id = u.id
-		WHERE m.room_id = $1
+		LEFT JOIN users u ON m.sender_id = u.id
+		WHERE m.room_id = $1 AND m.parent_id IS NULL
 		ORDER BY m.created_at DESC
 		LIMIT $2 OFFSET $3
 	`
@@ -100,11 +290,44 @@ func (r *Repository) GetRoomMessages(ctx context.Context, roomID, limit, offset
 
 	var messages []Message
 	for rows.Next() {
-		var msg Message
-		if err := rows.Scan(&msg.ID, &msg.Content, &msg.SenderID, &msg.RoomID, &msg.SenderName, &msg.Timestamp); err != nil {
+		msg, err := scanMessage(rows)
+		if err != nil {
+			return nil, err
+		}
+		messages = append(messages, *msg)
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+
+	return messages, nil
+}
+
+// GetMessagesAfter retrieves up to limit messages of a room after position
+// afterSeq, in the order they were committed
+func (r *Repository) GetMessagesAfter(ctx context.Context, roomID, afterSeq, limit int64) ([]Message, error) {
+	query := `
+		SELECT ` + messageColumns + `
+		FROM messages m
+		LEFT JOIN users u ON m.sender_id = u.id
+		WHERE m.room_id = $1 AND m.room_seq > $2
+		ORDER BY m.room_seq
+		LIMIT $3
+	`
+	rows, err := r.db.QueryContext(ctx, query, roomID, afterSeq, limit)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var messages []Message
+	for rows.Next() {
+		msg, err := scanMessage(rows)
+		if err != nil {
 			return nil, err
 		}
-		messages = append(messages, msg)
+		messages = append(messages, *msg)
 	}
 
 	if err := rows.Err(); err != nil {
@@ -114,30 +337,162 @@ func (r *Repository) GetRoomMessages(ctx context.Context, roomID, limit, offset
 	return messages, nil
 }
 
-// IsRoomMember checks if a user is a member of a room
+// GetLatestMessageSeq retrieves the position of the last message committed in
+// a room, or 0 if the room has no messages
+func (r *Repository) GetLatestMessageSeq(ctx context.Context, roomID int64) (int64, error) {
+	var seq int64
+	query := `SELECT COALESCE(MAX(room_seq), 0) FROM messages WHERE room_id = $1`
+	err := r.db.QueryRowContext(ctx, query, roomID).Scan(&seq)
+	return seq, err
+}
+
+// GetMessageSeq retrieves the position of a message in a room. It returns
+// sql.ErrNoRows if the message is not in the room.
+func (r *Repository) GetMessageSeq(ctx context.Context, roomID, messageID int64) (int64, error) {
+	var seq int64
+	query := `SELECT COALESCE(room_seq, 0) FROM messages WHERE id = $1 AND room_id = $2`
+	err := r.db.QueryRowContext(ctx, query, messageID, roomID).Scan(&seq)
+	return seq, err
+}
+
+// IsRoomMember checks if a user is a member of a room. Nobody is a member of
+// a deleted room.
 func (r *Repository) IsRoomMember(ctx context.Context, roomID, userID int64) (bool, error) {
 	var exists bool
-	query := `SELECT EXISTS(SELECT 1 FROM room_members WHERE room_id = $1 AND user_id = $2)`
+	query := `
+		SELECT EXISTS(
+			SELECT 1 FROM room_members rm
+			JOIN rooms r ON r.id = rm.room_id
+			WHERE rm.room_id = $1 AND rm.user_id = $2 AND r.deleted_at IS NULL
+		)
+	`
 	err := r.db.QueryRowContext(ctx, query, roomID, userID).Scan(&exists)
 	return exists, err
 }
 
-// SubscribeToRoom subscribes to messages in a room
-func (r *Repository) SubscribeToRoom(roomID int64, ch chan Message) {
+// TouchPresence records that a user is currently connected
+func (r *Repository) TouchPresence(ctx context.Context, userID int64) error {
+	_, err := r.db.ExecContext(ctx, `UPDATE users SET last_seen_at = NOW() WHERE id = $1`, userID)
+	return err
+}
+
+// IsRoomArchived checks if a room is archived and therefore read-only. It
+// returns sql.ErrNoRows if the room does not exist or has been deleted.
+func (r *Repository) IsRoomArchived(ctx context.Context, roomID int64) (bool, error) {
+	var archived bool
+	query := `SELECT archived_at IS NOT NULL FROM rooms WHERE id = $1 AND deleted_at IS NULL`
+	err := r.db.QueryRowContext(ctx, query, roomID).Scan(&archived)
+	return archived, err
+}
+
+// IsMuted checks if a user is muted in a room
+func (r *Repository) IsMuted(ctx context.Context, roomID, userID int64) (bool, error) {
+	var muted bool
+	query := `
+		SELECT EXISTS(
+			SELECT 1 FROM room_mutes
+			WHERE room_id = $1 AND user_id = $2 AND (expires_at IS NULL OR expires_at > NOW())
+		)
+	`
+	err := r.db.QueryRowContext(ctx, query, roomID, userID).Scan(&muted)
+	return muted, err
+}
+
+// MembershipEnd tells a watcher why a membership ended
+type MembershipEnd int
+
+// Reasons a membership can end
+const (
+	MemberRemoved MembershipEnd = iota + 1
+	RoomDeleted
+)
+
+// WatchMembership returns a channel that receives a value when the user is
+// removed from the room or the room is deleted, and a function to stop
+// watching
+func (r *Repository) WatchMembership(roomID, userID int64) (<-chan MembershipEnd, func()) {
+	key := memberKey{roomID: roomID, userID: userID}
+	ch := make(chan MembershipEnd, 1)
+
+	r.memberWatchesMutex.Lock()
+	r.memberWatches[key] = append(r.memberWatches[key], ch)
+	r.memberWatchesMutex.Unlock()
+
+	stop := func() {
+		r.memberWatchesMutex.Lock()
+		defer r.memberWatchesMutex.Unlock()
+
+		watches := r.memberWatches[key]
+		for i, w := range watches {
+			if w == ch {
+				r.memberWatches[key] = append(watches[:i], watches[i+1:]...)
+				break
+			}
+		}
+		if len(r.memberWatches[key]) == 0 {
+			delete(r.memberWatches, key)
+		}
+	}
+	return ch, stop
+}
+
+// EndMembership notifies the watchers of a user who was removed from a room
+func (r *Repository) EndMembership(roomID, userID int64, reason MembershipEnd) {
+	key := memberKey{roomID: roomID, userID: userID}
+
+	r.memberWatchesMutex.Lock()
+	defer r.memberWatchesMutex.Unlock()
+
+	r.endWatches(key, reason)
+}
+
+// EndRoom notifies the watchers of every member of a deleted room
+func (r *Repository) EndRoom(roomID int64, reason MembershipEnd) {
+	r.memberWatchesMutex.Lock()
+	defer r.memberWatchesMutex.Unlock()
+
+	for key := range r.memberWatches {
+		if key.roomID == roomID {
+			r.endWatches(key, reason)
+		}
+	}
+}
+
+// endWatches notifies and drops the watchers of a membership. The caller must
+// hold memberWatchesMutex.
+func (r *Repository) endWatches(key memberKey, reason MembershipEnd) {
+	for _, ch := range r.memberWatches[key] {
+		// Each channel has room for exactly one reason
+		select {
+		case ch <- reason:
+		default:
+		}
+	}
+	delete(r.memberWatches, key)
+}
+
+// SubscribeToRoom subscribes to the events of a room, queueing up to depth
+// events. policy decides what happens when the queue is full.
+func (r *Repository) SubscribeToRoom(roomID int64, depth int, policy SlowConsumerPolicy) *Subscription {
+	sub := newSubscription(roomID, depth, policy)
+
 	r.roomSubscriptionMutex.Lock()
 	defer r.roomSubscriptionMutex.Unlock()
 
-	r.roomSubscriptions[roomID] = append(r.roomSubscriptions[roomID], ch)
+	r.roomSubscriptions[roomID] = append(r.roomSubscriptions[roomID], sub)
+	return sub
 }
 
-// UnsubscribeFromRoom unsubscribes from messages in a room
-func (r *Repository) UnsubscribeFromRoom(roomID int64, ch chan Message) {
+// UnsubscribeFromRoom unsubscribes from the events of a room
+func (r *Repository) UnsubscribeFromRoom(sub *Subscription) {
+	roomID := sub.roomID
+
 	r.roomSubscriptionMutex.Lock()
 	defer r.roomSubscriptionMutex.Unlock()
 
 	subs := r.roomSubscriptions[roomID]
-	for i, sub := range subs {
-		if sub == ch {
+	for i, s := range subs {
+		if s == sub {
 			// Remove the channel from the slice
 			r.roomSubscriptions[roomID] = append(subs[:i], subs[i+1:]...)
 			break
@@ -150,18 +505,37 @@ func (r *Repository) UnsubscribeFromRoom(roomID int64, ch chan Message) {
 	}
 }
 
-// NotifyRoomSubscribers notifies all subscribers of a new message
+// NotifyRoomSubscribers notifies all subscribers of a new message, on every
+// instance
 func (r *Repository) NotifyRoomSubscribers(roomID int64, message Message) {
+	r.PublishRoomEvent(Event{
+		Type:    EventMessage,
+		RoomID:  roomID,
+		Time:    message.Timestamp,
+		Message: &message,
+	})
+}
+
+// PublishRoomEvent sends an event to all subscribers of its room, on every
+// instance
+func (r *Repository) PublishRoomEvent(event Event) {
+	r.broadcaster.Broadcast(event)
+}
+
+// DeliverRoomEvent sends an event to the subscribers of its room on this
+// instance only. It is used for events every instance learns of on its own.
+func (r *Repository) DeliverRoomEvent(event Event) {
 	r.roomSubscriptionMutex.RLock()
 	defer r.roomSubscriptionMutex.RUnlock()
 
-	for _, ch := range r.roomSubscriptions[roomID] {
-		// Use non-blocking send to avoid deadlocks
-		select {
-		case ch <- message:
-			// Message sent successfully
-		default:
-			// Channel is full or closed, skip
+	for _, sub := range r.roomSubscriptions[event.RoomID] {
+		// Never block on a slow subscriber
+		dropped, overflowed := sub.offer(event)
+		if dropped {
+			r.droppedEvents.Add(1)
+		}
+		if overflowed {
+			r.overflows.Add(1)
 		}
 	}
 }
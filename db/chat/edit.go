@@ -0,0 +1,96 @@
+package chat
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"time"
+)
+
+// ErrMessageNotFound is returned when a message does not exist or has been
+// deleted
+var ErrMessageNotFound = errors.New("message not found")
+
+// GetMemberRole retrieves a user's role in a room. It returns sql.ErrNoRows if
+// the user is not a member or the room has been deleted.
+func (r *Repository) GetMemberRole(ctx context.Context, roomID, userID int64) (string, error) {
+	var role string
+	query := `
+		SELECT rm.role FROM room_members rm
+		JOIN rooms r ON r.id = rm.room_id
+		WHERE rm.room_id = $1 AND rm.user_id = $2 AND r.deleted_at IS NULL
+	`
+	err := r.db.QueryRowContext(ctx, query, roomID, userID).Scan(&role)
+	return role, err
+}
+
+// EditMessage replaces the content of a message, keeping the previous content
+// as a revision. Call PublishMessageChanged to notify subscribers.
+func (r *Repository) EditMessage(ctx context.Context, messageID, editorID int64, content string) error {
+	return r.reviseMessage(ctx, messageID, editorID,
+		`UPDATE messages SET content = $2, edited_at = NOW() WHERE id = $1`,
+		messageID, content,
+	)
+}
+
+// DeleteMessage replaces a message with a tombstone, keeping its content as a
+// revision. Call PublishMessageChanged to notify subscribers.
+func (r *Repository) DeleteMessage(ctx context.Context, messageID, deletedBy int64) error {
+	return r.reviseMessage(ctx, messageID, deletedBy,
+		`UPDATE messages SET content = '', deleted_at = NOW(), deleted_by = $2 WHERE id = $1`,
+		messageID, deletedBy,
+	)
+}
+
+// reviseMessage saves the current content of a message to its revision
+// history and then runs update, in one transaction. It returns
+// ErrMessageNotFound if the message does not exist or has been deleted.
+func (r *Repository) reviseMessage(ctx context.Context, messageID, userID int64, update string, args ...interface{}) error {
+	tx, err := r.db.BeginTx(ctx, nil)
+	if err != nil {
+		return err
+	}
+	defer tx.Rollback()
+
+	var content string
+	err = tx.QueryRowContext(ctx,
+		`SELECT content FROM messages WHERE id = $1 AND deleted_at IS NULL FOR UPDATE`,
+		messageID,
+	).Scan(&content)
+	if errors.Is(err, sql.ErrNoRows) {
+		return ErrMessageNotFound
+	}
+	if err != nil {
+		return err
+	}
+
+	_, err = tx.ExecContext(ctx,
+		`INSERT INTO message_revisions (message_id, content, replaced_by) VALUES ($1, $2, $3)`,
+		messageID, content, userID,
+	)
+	if err != nil {
+		return err
+	}
+
+	if _, err := tx.ExecContext(ctx, update, args...); err != nil {
+		return err
+	}
+
+	return tx.Commit()
+}
+
+// PublishMessageChanged sends the current state of a changed message to
+// subscribers
+func (r *Repository) PublishMessageChanged(ctx context.Context, messageID int64, eventType EventType) error {
+	msg, err := r.GetMessage(ctx, messageID)
+	if err != nil {
+		return err
+	}
+	r.PublishRoomEvent(Event{
+		Type:    eventType,
+		RoomID:  msg.RoomID,
+		Time:    time.Now(),
+		Message: msg,
+	})
+	return nil
+}
@@ -0,0 +1,80 @@
+package chat
+
+import (
+	"context"
+	"time"
+)
+
+// EventType is the kind of a room event
+type EventType string
+
+// Room event types
+const (
+	EventMessage      EventType = "message"
+	EventEdit         EventType = "edit"
+	EventDelete       EventType = "delete"
+	EventReaction     EventType = "reaction"
+	EventTyping       EventType = "typing"
+	EventPresence     EventType = "presence"
+	EventMemberJoined EventType = "member_joined"
+	EventMemberLeft   EventType = "member_left"
+	EventRoomUpdated  EventType = "room_updated"
+	// EventRoomDeleted is sent last to a stream on a room that was deleted
+	EventRoomDeleted EventType = "room_deleted"
+	// EventResync is sent to a stream that caught up after an overflow. Only
+	// new messages are replayed, so other events may have been missed.
+	EventResync EventType = "resync"
+)
+
+// Event is something that happened in a room, as delivered to subscribers
+type Event struct {
+	Type   EventType
+	RoomID int64
+	Time   time.Time
+
+	// Set for message, edit, delete and reaction events
+	Message *Message
+
+	// The user concerned by reaction, typing, presence and member events
+	UserID   int64
+	Username string
+	// Whether the user added the reaction, started typing, or came online
+	Active bool
+
+	// Set for reaction events
+	Emoji string
+
+	// Set for room updated events
+	Room *RoomInfo
+}
+
+// RoomInfo is the part of a room shown to its members
+type RoomInfo struct {
+	Name        string
+	Description string
+	Topic       string
+	AvatarURL   string
+	Archived    bool
+}
+
+// GetRoomInfo retrieves the details of a room
+func (r *Repository) GetRoomInfo(ctx context.Context, roomID int64) (*RoomInfo, error) {
+	info := &RoomInfo{}
+	query := `
+		SELECT name, COALESCE(description, ''), topic, avatar_url, archived_at IS NOT NULL
+		FROM rooms WHERE id = $1
+	`
+	err := r.db.QueryRowContext(ctx, query, roomID).Scan(
+		&info.Name, &info.Description, &info.Topic, &info.AvatarURL, &info.Archived)
+	if err != nil {
+		return nil, err
+	}
+	return info, nil
+}
+
+// GetUsername retrieves the username of a user
+func (r *Repository) GetUsername(ctx context.Context, userID int64) (string, error) {
+	var username string
+	err := r.db.QueryRowContext(ctx, `SELECT username FROM users WHERE id = $1`, userID).Scan(&username)
+	return username, err
+}
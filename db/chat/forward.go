@@ -0,0 +1,65 @@
+package chat
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+)
+
+// ErrReplyToNotFound is returned when the message quoted by a reply is not in
+// the room or has been deleted
+var ErrReplyToNotFound = errors.New("message replied to not found")
+
+// checkQuoted checks that a message can be quoted in a room
+func checkQuoted(ctx context.Context, tx *sql.Tx, messageID, roomID int64) error {
+	var exists bool
+	query := `
+		SELECT EXISTS(
+			SELECT 1 FROM messages WHERE id = $1 AND room_id = $2 AND deleted_at IS NULL
+		)
+	`
+	if err := tx.QueryRowContext(ctx, query, messageID, roomID).Scan(&exists); err != nil {
+		return err
+	}
+	if !exists {
+		return ErrReplyToNotFound
+	}
+	return nil
+}
+
+// forwardOrigin is the content of a forwarded message and where it was first
+// posted
+type forwardOrigin struct {
+	content    string
+	roomID     int64
+	senderID   int64
+	senderName string
+}
+
+// readForwarded returns the origin of a message a user forwards. Forwarding a
+// forwarded message keeps the original room and sender. It returns
+// ErrMessageNotFound if the message does not exist, has been deleted, or is
+// in a room the user is not a member of.
+func readForwarded(ctx context.Context, tx *sql.Tx, messageID, userID int64) (forwardOrigin, error) {
+	var origin forwardOrigin
+	query := `
+		SELECT o.content, o.room_id, COALESCE(o.sender_id, 0), COALESCE(u.username, '` + SystemSenderName + `')
+		FROM (
+			SELECT m.content,
+				COALESCE(m.forwarded_from_room_id, m.room_id) AS room_id,
+				CASE WHEN m.forwarded_from_room_id IS NULL THEN m.sender_id
+					ELSE m.forwarded_from_sender_id END AS sender_id
+			FROM messages m
+			JOIN rooms r ON r.id = m.room_id
+			JOIN room_members rm ON rm.room_id = m.room_id AND rm.user_id = $2
+			WHERE m.id = $1 AND m.deleted_at IS NULL AND r.deleted_at IS NULL
+		) o
+		LEFT JOIN users u ON u.id = o.sender_id
+	`
+	err := tx.QueryRowContext(ctx, query, messageID, userID).Scan(
+		&origin.content, &origin.roomID, &origin.senderID, &origin.senderName)
+	if errors.Is(err, sql.ErrNoRows) {
+		return forwardOrigin{}, ErrMessageNotFound
+	}
+	return origin, err
+}
@@ -0,0 +1,117 @@
+package chat
+
+import (
+	"context"
+	"crypto/rand"
+	"encoding/hex"
+	"time"
+)
+
+// viewerTTL is how long an instance's viewer row counts without being
+// refreshed. Open streams refresh it every PresenceHeartbeat, so the rows of
+// an instance that stopped without closing its streams expire on their own.
+const viewerTTL = 3 * PresenceHeartbeat
+
+// newInstanceID returns a random ID for this chat service instance
+func newInstanceID() string {
+	b := make([]byte, 8)
+	if _, err := rand.Read(b); err != nil {
+		return time.Now().Format("20060102150405.000000000")
+	}
+	return hex.EncodeToString(b)
+}
+
+// AddViewer records that a user opened a stream on a room. It reports whether
+// the user came online, i.e. this is their first open stream there on any
+// instance.
+func (r *Repository) AddViewer(ctx context.Context, roomID, userID int64) (bool, error) {
+	key := memberKey{roomID: roomID, userID: userID}
+
+	r.viewersMutex.Lock()
+	defer r.viewersMutex.Unlock()
+
+	r.viewers[key]++
+	if r.viewers[key] > 1 {
+		return false, nil
+	}
+	return r.setViewing(ctx, roomID, userID, true)
+}
+
+// RemoveViewer records that a user closed a stream on a room. It reports
+// whether the user went offline, i.e. that was their last open stream there
+// on any instance.
+func (r *Repository) RemoveViewer(ctx context.Context, roomID, userID int64) (bool, error) {
+	key := memberKey{roomID: roomID, userID: userID}
+
+	r.viewersMutex.Lock()
+	defer r.viewersMutex.Unlock()
+
+	r.viewers[key]--
+	if r.viewers[key] > 0 {
+		return false, nil
+	}
+	delete(r.viewers, key)
+	return r.setViewing(ctx, roomID, userID, false)
+}
+
+// TouchViewer keeps this instance's viewer row for a user in a room from
+// expiring while their streams are open
+func (r *Repository) TouchViewer(ctx context.Context, roomID, userID int64) error {
+	_, err := r.db.ExecContext(ctx,
+		`UPDATE room_viewers SET seen_at = NOW() WHERE room_id = $1 AND user_id = $2 AND instance_id = $3`,
+		roomID, userID, r.instanceID,
+	)
+	return err
+}
+
+// setViewing adds or removes this instance's viewer row for a user in a room,
+// and reports whether no other instance has a stream of theirs open there.
+// The user's rows are locked first, so instances opening or closing streams
+// at the same time agree on which one was first or last.
+func (r *Repository) setViewing(ctx context.Context, roomID, userID int64, viewing bool) (bool, error) {
+	tx, err := r.db.BeginTx(ctx, nil)
+	if err != nil {
+		return false, err
+	}
+	defer tx.Rollback()
+
+	if _, err := tx.ExecContext(ctx, `SELECT pg_advisory_xact_lock($1, $2)`, roomID, userID); err != nil {
+		return false, err
+	}
+
+	seenAfter := time.Now().Add(-viewerTTL)
+	if viewing {
+		_, err = tx.ExecContext(ctx,
+			`DELETE FROM room_viewers WHERE room_id = $1 AND user_id = $2 AND seen_at <= $3`,
+			roomID, userID, seenAfter,
+		)
+		if err != nil {
+			return false, err
+		}
+		_, err = tx.ExecContext(ctx, `
+			INSERT INTO room_viewers (room_id, user_id, instance_id) VALUES ($1, $2, $3)
+			ON CONFLICT (room_id, user_id, instance_id) DO UPDATE SET seen_at = NOW()
+		`, roomID, userID, r.instanceID)
+	} else {
+		_, err = tx.ExecContext(ctx,
+			`DELETE FROM room_viewers WHERE room_id = $1 AND user_id = $2 AND instance_id = $3`,
+			roomID, userID, r.instanceID,
+		)
+	}
+	if err != nil {
+		return false, err
+	}
+
+	var elsewhere bool
+	err = tx.QueryRowContext(ctx, `
+		SELECT EXISTS(
+			SELECT 1 FROM room_viewers
+			WHERE room_id = $1 AND user_id = $2 AND instance_id <> $3 AND seen_at > $4
+		)
+	`, roomID, userID, r.instanceID, seenAfter).Scan(&elsewhere)
+	if err != nil {
+		return false, err
+	}
+
+	return !elsewhere, tx.Commit()
+}
@@ -0,0 +1,120 @@
+package chat
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/lib/pq"
+)
+
+// Errors returned when changing reactions
+var (
+	ErrAlreadyReacted   = errors.New("user already reacted with this emoji")
+	ErrReactionNotFound = errors.New("reaction not found")
+)
+
+// Reaction is the number of users who reacted to a message with an emoji
+type Reaction struct {
+	Emoji string
+	Count int64
+	// Whether the user the reactions were loaded for is among them
+	ReactedByMe bool
+}
+
+// AddReaction adds a user's emoji reaction to a message. It returns
+// ErrAlreadyReacted if the user already reacted to the message with that
+// emoji. Call PublishReaction to notify subscribers.
+func (r *Repository) AddReaction(ctx context.Context, messageID, userID int64, emoji string) error {
+	result, err := r.db.ExecContext(ctx, `
+		INSERT INTO message_reactions (message_id, user_id, emoji) VALUES ($1, $2, $3)
+		ON CONFLICT DO NOTHING
+	`, messageID, userID, emoji)
+	if err != nil {
+		return err
+	}
+	if n, err := result.RowsAffected(); err != nil {
+		return err
+	} else if n == 0 {
+		return ErrAlreadyReacted
+	}
+	return nil
+}
+
+// RemoveReaction removes a user's emoji reaction from a message. It returns
+// ErrReactionNotFound if the user had not reacted to the message with that
+// emoji. Call PublishReaction to notify subscribers.
+func (r *Repository) RemoveReaction(ctx context.Context, messageID, userID int64, emoji string) error {
+	result, err := r.db.ExecContext(ctx, `
+		DELETE FROM message_reactions WHERE message_id = $1 AND user_id = $2 AND emoji = $3
+	`, messageID, userID, emoji)
+	if err != nil {
+		return err
+	}
+	if n, err := result.RowsAffected(); err != nil {
+		return err
+	} else if n == 0 {
+		return ErrReactionNotFound
+	}
+	return nil
+}
+
+// GetReactions retrieves the reactions to messages, by message ID, in the
+// order each emoji was first used. ReactedByMe is set for userID.
+func (r *Repository) GetReactions(ctx context.Context, messageIDs []int64, userID int64) (map[int64][]Reaction, error) {
+	reactions := make(map[int64][]Reaction)
+	if len(messageIDs) == 0 {
+		return reactions, nil
+	}
+
+	query := `
+		SELECT message_id, emoji, COUNT(*), BOOL_OR(user_id = $2)
+		FROM message_reactions
+		WHERE message_id = ANY($1)
+		GROUP BY message_id, emoji
+		ORDER BY message_id, MIN(created_at), emoji
+	`
+	rows, err := r.db.QueryContext(ctx, query, pq.Array(messageIDs), userID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	for rows.Next() {
+		var messageID int64
+		var reaction Reaction
+		if err := rows.Scan(&messageID, &reaction.Emoji, &reaction.Count, &reaction.ReactedByMe); err != nil {
+			return nil, err
+		}
+		reactions[messageID] = append(reactions[messageID], reaction)
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+
+	return reactions, nil
+}
+
+// PublishReaction tells subscribers that a user added or removed a reaction
+func (r *Repository) PublishReaction(ctx context.Context, messageID, userID int64, emoji string, added bool) error {
+	msg, err := r.GetMessage(ctx, messageID)
+	if err != nil {
+		return err
+	}
+	username, err := r.GetUsername(ctx, userID)
+	if err != nil {
+		return err
+	}
+	r.PublishRoomEvent(Event{
+		Type:     EventReaction,
+		RoomID:   msg.RoomID,
+		Time:     time.Now(),
+		Message:  msg,
+		UserID:   userID,
+		Username: username,
+		Emoji:    emoji,
+		Active:   added,
+	})
+	return nil
+}
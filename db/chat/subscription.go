@@ -0,0 +1,149 @@
+package chat
+
+import (
+	"sync"
+	"sync/atomic"
+)
+
+// SlowConsumerPolicy decides what happens to a subscription whose queue is
+// full when a new event arrives
+type SlowConsumerPolicy string
+
+// Slow consumer policies
+const (
+	// PolicyDropOldest discards the oldest queued event to make room
+	PolicyDropOldest SlowConsumerPolicy = "drop_oldest"
+	// PolicyDisconnect stops queueing and signals Overflow, so the stream
+	// can be closed
+	PolicyDisconnect SlowConsumerPolicy = "disconnect"
+	// PolicyReplay stops queueing and signals Overflow until Resume is
+	// called, so the stream can catch up from the database. Only new
+	// messages can be caught up on; other events are lost.
+	PolicyReplay SlowConsumerPolicy = "replay"
+)
+
+// Valid reports whether p is a known policy
+func (p SlowConsumerPolicy) Valid() bool {
+	switch p {
+	case PolicyDropOldest, PolicyDisconnect, PolicyReplay:
+		return true
+	}
+	return false
+}
+
+// Subscription is a stream's bounded queue of events from a room
+type Subscription struct {
+	roomID int64
+	policy SlowConsumerPolicy
+	events chan Event
+
+	// Serializes offers, so dropping the oldest event always makes room
+	mutex sync.Mutex
+	// Set when the queue overflowed, until Resume
+	overflowed bool
+	overflow   chan struct{}
+
+	dropped atomic.Int64
+}
+
+// newSubscription creates a subscription queueing up to depth events
+func newSubscription(roomID int64, depth int, policy SlowConsumerPolicy) *Subscription {
+	if depth < 1 {
+		depth = 1
+	}
+	return &Subscription{
+		roomID:   roomID,
+		policy:   policy,
+		events:   make(chan Event, depth),
+		overflow: make(chan struct{}, 1),
+	}
+}
+
+// Events returns the queued events, oldest first
+func (s *Subscription) Events() <-chan Event {
+	return s.events
+}
+
+// Overflow receives a value when the queue overflowed under PolicyDisconnect
+// or PolicyReplay. Events are dropped from then on, until Resume.
+func (s *Subscription) Overflow() <-chan struct{} {
+	return s.overflow
+}
+
+// Resume queues events again after an overflow
+func (s *Subscription) Resume() {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+
+	s.overflowed = false
+}
+
+// Dropped returns the number of events the subscription did not queue
+func (s *Subscription) Dropped() int64 {
+	return s.dropped.Load()
+}
+
+// offer queues an event without blocking. It reports whether an event was
+// dropped, and whether the queue just overflowed.
+func (s *Subscription) offer(event Event) (dropped, overflowed bool) {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+
+	if s.overflowed {
+		s.dropped.Add(1)
+		return true, false
+	}
+
+	select {
+	case s.events <- event:
+		return false, false
+	default:
+	}
+
+	s.dropped.Add(1)
+	if s.policy == PolicyDropOldest {
+		// The reader may have taken an event in the meantime, in which case
+		// nothing needs to be dropped
+		select {
+		case <-s.events:
+		default:
+		}
+		s.events <- event
+		return true, false
+	}
+
+	s.overflowed = true
+	select {
+	case s.overflow <- struct{}{}:
+	default:
+	}
+	return true, true
+}
+
+// StreamStats counts the subscriptions of this instance and the events they
+// missed
+type StreamStats struct {
+	// Open subscriptions
+	Subscriptions int64
+	// Events not queued because a subscription's queue was full
+	DroppedEvents int64
+	// Subscriptions whose queue overflowed under PolicyDisconnect or
+	// PolicyReplay
+	Overflows int64
+}
+
+// StreamStats returns the subscription counters of this instance
+func (r *Repository) StreamStats() StreamStats {
+	r.roomSubscriptionMutex.RLock()
+	var open int64
+	for _, subs := range r.roomSubscriptions {
+		open += int64(len(subs))
+	}
+	r.roomSubscriptionMutex.RUnlock()
+
+	return StreamStats{
+		Subscriptions: open,
+		DroppedEvents: r.droppedEvents.Load(),
+		Overflows:     r.overflows.Load(),
+	}
+}
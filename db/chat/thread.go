@@ -0,0 +1,72 @@
+package chat
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+)
+
+// threadOf returns the first message of the thread a reply to messageID
+// belongs to. It returns ErrMessageNotFound if the message is not in the
+// room or the thread's first message has been deleted.
+func threadOf(ctx context.Context, tx *sql.Tx, messageID, roomID int64) (int64, error) {
+	var threadID int64
+	query := `
+		SELECT t.id
+		FROM messages m
+		JOIN messages t ON t.id = COALESCE(m.parent_id, m.id)
+		WHERE m.id = $1 AND m.room_id = $2 AND t.deleted_at IS NULL
+	`
+	err := tx.QueryRowContext(ctx, query, messageID, roomID).Scan(&threadID)
+	if errors.Is(err, sql.ErrNoRows) {
+		return 0, ErrMessageNotFound
+	}
+	return threadID, err
+}
+
+// PublishThreadChanged sends the current state of the first message of the
+// thread a reply belongs to, with its new reply count and last reply time,
+// to subscribers as an edit
+func (r *Repository) PublishThreadChanged(ctx context.Context, replyID int64) error {
+	reply, err := r.GetMessage(ctx, replyID)
+	if err != nil {
+		return err
+	}
+	if reply.ParentID == 0 {
+		return nil
+	}
+	return r.PublishMessageChanged(ctx, reply.ParentID, EventEdit)
+}
+
+// GetThreadReplies retrieves the replies in the thread of a message, oldest
+// first
+func (r *Repository) GetThreadReplies(ctx context.Context, threadID, limit, offset int64) ([]Message, error) {
+	query := `
+		SELECT ` + messageColumns + `
+		FROM messages m
+		LEFT JOIN users u ON m.sender_id = u.id
+		WHERE m.parent_id = $1
+		ORDER BY m.id
+		LIMIT $2 OFFSET $3
+	`
+	rows, err := r.db.QueryContext(ctx, query, threadID, limit, offset)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var messages []Message
+	for rows.Next() {
+		msg, err := scanMessage(rows)
+		if err != nil {
+			return nil, err
+		}
+		messages = append(messages, *msg)
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+
+	return messages, nil
+}
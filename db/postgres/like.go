@@ -0,0 +1,12 @@
+package postgres
+
+import "strings"
+
+// likeEscaper escapes the characters that are special in LIKE patterns
+var likeEscaper = strings.NewReplacer(`\`, `\\`, `%`, `\%`, `_`, `\_`)
+
+// EscapeLike escapes s for use in a LIKE or ILIKE pattern with ESCAPE '\',
+// so it only matches itself
+func EscapeLike(s string) string {
+	return likeEscaper.Replace(s)
+}
@@ -0,0 +1,106 @@
+package postgres
+
+import (
+	"context"
+	"database/sql"
+	"encoding/json"
+	"log"
+	"time"
+
+	"github.com/lib/pq"
+)
+
+// RoomEventsChannel is the NOTIFY channel on which room changes are published
+// so that every service instance can react to them
+const RoomEventsChannel = "room_events"
+
+// Room event types
+const (
+	RoomEventMemberRemoved = "member_removed"
+	RoomEventRoomDeleted   = "room_deleted"
+	RoomEventMessage       = "message"
+	RoomEventMemberAdded   = "member_added"
+	RoomEventRoomUpdated   = "room_updated"
+)
+
+// RoomEvent is the payload of a notification on RoomEventsChannel
+type RoomEvent struct {
+	Type   string `json:"type"`
+	RoomID int64  `json:"room_id"`
+	UserID int64  `json:"user_id,omitempty"`
+	// Set for RoomEventMessage
+	MessageID int64 `json:"message_id,omitempty"`
+}
+
+// Execer is implemented by *sql.DB and *sql.Tx
+type Execer interface {
+	ExecContext(ctx context.Context, query string, args ...interface{}) (sql.Result, error)
+}
+
+// NotifyRoomEvent publishes a room event. Inside a transaction the
+// notification is only delivered once the transaction commits.
+func NotifyRoomEvent(ctx context.Context, db Execer, event RoomEvent) error {
+	return Notify(ctx, db, RoomEventsChannel, event)
+}
+
+// ListenRoomEvents calls handle for every room event published on the
+// database until ctx is done. The listener reconnects on its own after
+// connection failures.
+func ListenRoomEvents(ctx context.Context, connStr string, logger *log.Logger, handle func(RoomEvent)) error {
+	return Listen(ctx, connStr, RoomEventsChannel, logger, func(payload []byte) {
+		var event RoomEvent
+		if err := json.Unmarshal(payload, &event); err != nil {
+			logger.Printf("Error decoding room event: %v", err)
+			return
+		}
+		handle(event)
+	})
+}
+
+// Notify publishes payload encoded as JSON on a NOTIFY channel. Inside a
+// transaction the notification is only delivered once the transaction
+// commits. Payloads must stay under 8000 bytes.
+func Notify(ctx context.Context, db Execer, channel string, payload interface{}) error {
+	b, err := json.Marshal(payload)
+	if err != nil {
+		return err
+	}
+	_, err = db.ExecContext(ctx, `SELECT pg_notify($1, $2)`, channel, string(b))
+	return err
+}
+
+// Listen calls handle with the payload of every notification on a channel
+// until ctx is done. The listener reconnects on its own after connection
+// failures; notifications sent while it is disconnected are lost.
+func Listen(ctx context.Context, connStr, channel string, logger *log.Logger, handle func(payload []byte)) error {
+	listener := pq.NewListener(connStr, 10*time.Second, time.Minute, func(ev pq.ListenerEventType, err error) {
+		if err != nil {
+			logger.Printf("Listener error on channel %s: %v", channel, err)
+		}
+	})
+	if err := listener.Listen(channel); err != nil {
+		listener.Close()
+		return err
+	}
+
+	go func() {
+		defer listener.Close()
+		for {
+			select {
+			case n := <-listener.Notify:
+				// A nil notification means the connection was re-established
+				if n == nil {
+					continue
+				}
+				handle([]byte(n.Extra))
+			case <-time.After(90 * time.Second):
+				// Check the connection is still alive
+				go listener.Ping()
+			case <-ctx.Done():
+				return
+			}
+		}
+	}()
+
+	return nil
+}
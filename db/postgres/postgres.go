@@ -18,8 +18,8 @@ const (
 	defaultSSLMode  = "disable"
 )
 
-// NewPostgresDB creates a new PostgreSQL database connection
-func NewPostgresDB() (*sql.DB, error) {
+// ConnString returns the PostgreSQL connection string
+func ConnString() string {
 	// Get connection parameters from environment variables or use defaults
 	host := getEnv("DB_HOST", defaultHost)
 	port := getEnv("DB_PORT", fmt.Sprintf("%d", defaultPort))
@@ -28,14 +28,16 @@ func NewPostgresDB() (*sql.DB, error) {
 	dbname := getEnv("DB_NAME", defaultDBName)
 	sslmode := getEnv("DB_SSLMODE", defaultSSLMode)
 
-	// Create connection string
-	connStr := fmt.Sprintf(
+	return fmt.Sprintf(
 		"host=%s port=%s user=%s password=%s dbname=%s sslmode=%s",
 		host, port, user, password, dbname, sslmode,
 	)
+}
 
+// NewPostgresDB creates a new PostgreSQL database connection
+func NewPostgresDB() (*sql.DB, error) {
 	// Open database connection
-	db, err := sql.Open("postgres", connStr)
+	db, err := sql.Open("postgres", ConnString())
 	if err != nil {
 		return nil, fmt.Errorf("failed to open database connection: %w", err)
 	}
@@ -0,0 +1,143 @@
+package room
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"time"
+)
+
+// DirectConversation is a one-to-one conversation as seen by one of its two
+// members
+type DirectConversation struct {
+	RoomID       int64
+	PeerID       int64
+	PeerUsername string
+	CreatedAt    time.Time
+}
+
+// directConversationColumns is the column list scanned by
+// scanDirectConversation, for direct_conversations aliased as d joined with
+// rooms r and the peer in users u
+const directConversationColumns = `d.room_id, u.id, u.username, r.created_at`
+
+// scanDirectConversation scans a row selected with directConversationColumns
+func scanDirectConversation(row interface{ Scan(...interface{}) error }) (*DirectConversation, error) {
+	c := &DirectConversation{}
+	err := row.Scan(&c.RoomID, &c.PeerID, &c.PeerUsername, &c.CreatedAt)
+	return c, err
+}
+
+// GetDirectConversation retrieves the direct conversation between a user and
+// a peer. It returns sql.ErrNoRows if they have none.
+func (r *Repository) GetDirectConversation(ctx context.Context, userID, peerID int64) (*DirectConversation, error) {
+	query := `
+		SELECT ` + directConversationColumns + `
+		FROM direct_conversations d
+		JOIN rooms r ON r.id = d.room_id
+		JOIN users u ON u.id = $2
+		WHERE d.user_low = LEAST($1::integer, $2::integer)
+			AND d.user_high = GREATEST($1::integer, $2::integer)
+	`
+	return scanDirectConversation(r.db.QueryRowContext(ctx, query, userID, peerID))
+}
+
+// GetUserDirectConversations retrieves the direct conversations of a user,
+// newest first
+func (r *Repository) GetUserDirectConversations(ctx context.Context, userID int64) ([]DirectConversation, error) {
+	query := `
+		SELECT ` + directConversationColumns + `
+		FROM direct_conversations d
+		JOIN rooms r ON r.id = d.room_id
+		JOIN users u ON u.id = CASE WHEN d.user_low = $1 THEN d.user_high ELSE d.user_low END
+		WHERE d.user_low = $1 OR d.user_high = $1
+		ORDER BY r.created_at DESC, d.room_id DESC
+	`
+	rows, err := r.db.QueryContext(ctx, query, userID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var conversations []DirectConversation
+	for rows.Next() {
+		c, err := scanDirectConversation(rows)
+		if err != nil {
+			return nil, err
+		}
+		conversations = append(conversations, *c)
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+
+	return conversations, nil
+}
+
+// OpenDirectConversation returns the direct conversation between a user and
+// a peer, creating it with both of them as its only members if they have
+// none yet. It reports whether the conversation was created.
+func (r *Repository) OpenDirectConversation(ctx context.Context, userID, peerID int64) (*DirectConversation, bool, error) {
+	c, err := r.GetDirectConversation(ctx, userID, peerID)
+	if err == nil {
+		return c, false, nil
+	}
+	if !errors.Is(err, sql.ErrNoRows) {
+		return nil, false, err
+	}
+
+	created, err := r.createDirectConversation(ctx, userID, peerID)
+	if err != nil {
+		return nil, false, err
+	}
+
+	c, err = r.GetDirectConversation(ctx, userID, peerID)
+	return c, created, err
+}
+
+// createDirectConversation creates the room for a direct conversation. It
+// reports false if a concurrent request created the conversation first.
+func (r *Repository) createDirectConversation(ctx context.Context, userID, peerID int64) (bool, error) {
+	tx, err := r.db.BeginTx(ctx, nil)
+	if err != nil {
+		return false, err
+	}
+	defer tx.Rollback()
+
+	var roomID int64
+	err = tx.QueryRowContext(ctx, `
+		INSERT INTO rooms (name, creator_id, is_private, max_members, join_policy, kind)
+		VALUES ('', $1, TRUE, 2, $2, $3)
+		RETURNING id
+	`, userID, JoinPolicyInviteOnly, RoomKindDirect).Scan(&roomID)
+	if err != nil {
+		return false, err
+	}
+
+	result, err := tx.ExecContext(ctx, `
+		INSERT INTO direct_conversations (room_id, user_low, user_high)
+		VALUES ($1, LEAST($2::integer, $3::integer), GREATEST($2::integer, $3::integer))
+		ON CONFLICT (user_low, user_high) DO NOTHING
+	`, roomID, userID, peerID)
+	if err != nil {
+		return false, err
+	}
+	n, err := result.RowsAffected()
+	if err != nil {
+		return false, err
+	}
+	if n == 0 {
+		return false, nil
+	}
+
+	_, err = tx.ExecContext(ctx,
+		`INSERT INTO room_members (room_id, user_id, role) VALUES ($1, $2, 'member'), ($1, $3, 'member')`,
+		roomID, userID, peerID,
+	)
+	if err != nil {
+		return false, err
+	}
+
+	return true, tx.Commit()
+}
@@ -0,0 +1,104 @@
+package room
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"grpc-messenger-core/db/postgres"
+)
+
+// DirectorySort is the order of the public room directory
+type DirectorySort string
+
+// Directory sort orders, both descending
+const (
+	SortByMemberCount    DirectorySort = "member_count"
+	SortByRecentActivity DirectorySort = "recent_activity"
+)
+
+// DirectoryRoom is a public room as listed in the room directory
+type DirectoryRoom struct {
+	Room
+	MemberCount  int64
+	LastActivity time.Time
+}
+
+// DirectoryCursor is the position after which the next directory page starts:
+// the sort key and room ID of the last room on the previous page
+type DirectoryCursor struct {
+	MemberCount  int64     `json:"m,omitempty"`
+	LastActivity time.Time `json:"a,omitempty"`
+	RoomID       int64     `json:"id"`
+}
+
+// Cursor returns the cursor that continues after this room
+func (d *DirectoryRoom) Cursor(sort DirectorySort) DirectoryCursor {
+	if sort == SortByRecentActivity {
+		return DirectoryCursor{LastActivity: d.LastActivity, RoomID: d.ID}
+	}
+	return DirectoryCursor{MemberCount: d.MemberCount, RoomID: d.ID}
+}
+
+// SearchPublicRooms lists public, active rooms whose name or description
+// contains query, in the given order. Pass a nil cursor for the first page.
+func (r *Repository) SearchPublicRooms(ctx context.Context, query string, sort DirectorySort, after *DirectoryCursor, limit int64) ([]DirectoryRoom, error) {
+	// Keyset pagination on (sort key, id), both descending
+	var sortKey string
+	var cursorKey interface{}
+	switch sort {
+	case SortByMemberCount:
+		sortKey = "member_count"
+		if after != nil {
+			cursorKey = after.MemberCount
+		}
+	case SortByRecentActivity:
+		sortKey = "last_activity"
+		if after != nil {
+			cursorKey = after.LastActivity
+		}
+	default:
+		return nil, fmt.Errorf("unknown directory sort %q", sort)
+	}
+	var cursorID interface{}
+	if after != nil {
+		cursorID = after.RoomID
+	}
+
+	q := `
+		WITH directory AS (
+			SELECT ` + roomColumns + `,
+				(SELECT COUNT(*) FROM room_members rm WHERE rm.room_id = r.id) AS member_count,
+				COALESCE((SELECT MAX(m.created_at) FROM messages m WHERE m.room_id = r.id), r.created_at) AS last_activity
+			FROM rooms r
+			WHERE NOT r.is_private AND r.archived_at IS NULL AND r.deleted_at IS NULL
+				AND (r.name ILIKE '%' || $1 || '%' ESCAPE '\' OR r.description ILIKE '%' || $1 || '%' ESCAPE '\')
+		)
+		SELECT * FROM directory
+		WHERE $2::bigint IS NULL OR (` + sortKey + `, id) < ($3, $2)
+		ORDER BY ` + sortKey + ` DESC, id DESC
+		LIMIT $4
+	`
+	rows, err := r.db.QueryContext(ctx, q, postgres.EscapeLike(query), cursorID, cursorKey, limit)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var rooms []DirectoryRoom
+	for rows.Next() {
+		var d DirectoryRoom
+		room, err := scanRoom(rows, &d.MemberCount, &d.LastActivity)
+		if err != nil {
+			return nil, err
+		}
+		d.Room = *room
+		rooms = append(rooms, d)
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+
+	return rooms, nil
+}
@@ -0,0 +1,134 @@
+package room
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"time"
+)
+
+// Participant is a member of a group conversation
+type Participant struct {
+	UserID   int64
+	Username string
+}
+
+// GroupConversation is an unnamed conversation between three or more users
+type GroupConversation struct {
+	RoomID       int64
+	CreatedAt    time.Time
+	Participants []Participant
+}
+
+// CreateGroupConversation creates a group conversation with the creator and
+// the given users as members, limited to maxMembers participants
+func (r *Repository) CreateGroupConversation(ctx context.Context, creatorID int64, userIDs []int64, maxMembers int64) (int64, error) {
+	tx, err := r.db.BeginTx(ctx, nil)
+	if err != nil {
+		return 0, err
+	}
+	defer tx.Rollback()
+
+	var roomID int64
+	err = tx.QueryRowContext(ctx, `
+		INSERT INTO rooms (name, creator_id, is_private, max_members, join_policy, kind)
+		VALUES ('', $1, TRUE, NULLIF($2, 0), $3, $4)
+		RETURNING id
+	`, creatorID, maxMembers, JoinPolicyInviteOnly, RoomKindGroup).Scan(&roomID)
+	if err != nil {
+		return 0, err
+	}
+
+	for _, userID := range append([]int64{creatorID}, userIDs...) {
+		err = addMember(ctx, tx, roomID, userID, "member")
+		if err != nil && !errors.Is(err, ErrAlreadyMember) {
+			return 0, err
+		}
+	}
+
+	return roomID, tx.Commit()
+}
+
+// AddGroupMembers adds users to a group conversation in one transaction, so
+// either all of them are added or none. It returns the users that were not
+// members already.
+func (r *Repository) AddGroupMembers(ctx context.Context, roomID int64, userIDs []int64) ([]int64, error) {
+	tx, err := r.db.BeginTx(ctx, nil)
+	if err != nil {
+		return nil, err
+	}
+	defer tx.Rollback()
+
+	var added []int64
+	for _, userID := range userIDs {
+		err = addMember(ctx, tx, roomID, userID, "member")
+		if errors.Is(err, ErrAlreadyMember) {
+			continue
+		}
+		if err != nil {
+			return nil, err
+		}
+		added = append(added, userID)
+	}
+
+	return added, tx.Commit()
+}
+
+// GetGroupConversation retrieves a group conversation with its participants.
+// It returns sql.ErrNoRows if there is no such group conversation.
+func (r *Repository) GetGroupConversation(ctx context.Context, roomID int64) (*GroupConversation, error) {
+	groups, err := r.queryGroupConversations(ctx, `r.id = $1`, roomID)
+	if err != nil {
+		return nil, err
+	}
+	if len(groups) == 0 {
+		return nil, sql.ErrNoRows
+	}
+	return &groups[0], nil
+}
+
+// GetUserGroupConversations retrieves the group conversations of a user,
+// newest first
+func (r *Repository) GetUserGroupConversations(ctx context.Context, userID int64) ([]GroupConversation, error) {
+	return r.queryGroupConversations(ctx,
+		`r.id IN (SELECT room_id FROM room_members WHERE user_id = $1)`, userID)
+}
+
+// queryGroupConversations retrieves the group conversations matching where,
+// one row per participant
+func (r *Repository) queryGroupConversations(ctx context.Context, where string, args ...interface{}) ([]GroupConversation, error) {
+	query := `
+		SELECT r.id, r.created_at, u.id, u.username
+		FROM rooms r
+		JOIN room_members rm ON rm.room_id = r.id
+		JOIN users u ON u.id = rm.user_id
+		WHERE r.kind = 'group' AND r.deleted_at IS NULL AND ` + where + `
+		ORDER BY r.created_at DESC, r.id DESC, rm.joined_at, rm.user_id
+	`
+	rows, err := r.db.QueryContext(ctx, query, args...)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var groups []GroupConversation
+	for rows.Next() {
+		var roomID int64
+		var createdAt time.Time
+		var p Participant
+		if err := rows.Scan(&roomID, &createdAt, &p.UserID, &p.Username); err != nil {
+			return nil, err
+		}
+		if len(groups) == 0 || groups[len(groups)-1].RoomID != roomID {
+			groups = append(groups, GroupConversation{RoomID: roomID, CreatedAt: createdAt})
+		}
+		g := &groups[len(groups)-1]
+		g.Participants = append(g.Participants, p)
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+
+	return groups, nil
+}
@@ -0,0 +1,164 @@
+package room
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"time"
+)
+
+// ErrInviteNotFound is returned when an invite does not exist, belongs to
+// another user, or is no longer pending
+var ErrInviteNotFound = errors.New("invite not found")
+
+// Invite represents a pending invitation to a room
+type Invite struct {
+	ID          int64
+	RoomID      int64
+	RoomName    string
+	InviterID   int64
+	InviterName string
+	CreatedAt   time.Time
+	ExpiresAt   time.Time
+}
+
+// UserExists checks if a user with the given ID exists
+func (r *Repository) UserExists(ctx context.Context, userID int64) (bool, error) {
+	var exists bool
+	query := `SELECT EXISTS(SELECT 1 FROM users WHERE id = $1)`
+	err := r.db.QueryRowContext(ctx, query, userID).Scan(&exists)
+	return exists, err
+}
+
+// CreateInvite invites a user to a room. An expired pending invite for the
+// same user is renewed instead.
+func (r *Repository) CreateInvite(ctx context.Context, roomID, inviterID, inviteeID int64, expiresAt time.Time) (int64, error) {
+	var inviteID int64
+	query := `
+		INSERT INTO room_invites (room_id, inviter_id, invitee_id, expires_at)
+		VALUES ($1, $2, $3, $4)
+		ON CONFLICT (room_id, invitee_id) WHERE status = 'pending'
+		DO UPDATE SET inviter_id = EXCLUDED.inviter_id, created_at = NOW(), expires_at = EXCLUDED.expires_at
+		RETURNING id
+	`
+	err := r.db.QueryRowContext(ctx, query, roomID, inviterID, inviteeID, expiresAt).Scan(&inviteID)
+	return inviteID, err
+}
+
+// HasPendingInvite checks if a user has a pending, unexpired invite to a room
+func (r *Repository) HasPendingInvite(ctx context.Context, roomID, userID int64) (bool, error) {
+	var exists bool
+	query := `
+		SELECT EXISTS(
+			SELECT 1 FROM room_invites
+			WHERE room_id = $1 AND invitee_id = $2 AND status = 'pending' AND expires_at > NOW()
+		)
+	`
+	err := r.db.QueryRowContext(ctx, query, roomID, userID).Scan(&exists)
+	return exists, err
+}
+
+// GetPendingInvites retrieves the pending, unexpired invites of a user to
+// rooms that have not been deleted
+func (r *Repository) GetPendingInvites(ctx context.Context, userID int64) ([]Invite, error) {
+	query := `
+		SELECT i.id, i.room_id, r.name, i.inviter_id, u.username, i.created_at, i.expires_at
+		FROM room_invites i
+		JOIN rooms r ON r.id = i.room_id
+		JOIN users u ON u.id = i.inviter_id
+		WHERE i.invitee_id = $1 AND i.status = 'pending' AND i.expires_at > NOW()
+			AND r.deleted_at IS NULL
+		ORDER BY i.created_at DESC
+	`
+	rows, err := r.db.QueryContext(ctx, query, userID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var invites []Invite
+	for rows.Next() {
+		var invite Invite
+		if err := rows.Scan(&invite.ID, &invite.RoomID, &invite.RoomName, &invite.InviterID,
+			&invite.InviterName, &invite.CreatedAt, &invite.ExpiresAt); err != nil {
+			return nil, err
+		}
+		invites = append(invites, invite)
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+
+	return invites, nil
+}
+
+// AcceptInvite marks an invite as accepted and adds the invitee to the room
+// as a member. It returns the room ID, or ErrInviteNotFound if userID has no
+// such pending invite.
+func (r *Repository) AcceptInvite(ctx context.Context, inviteID, userID int64) (int64, error) {
+	query := `
+		UPDATE room_invites SET status = 'accepted', responded_at = NOW()
+		WHERE id = $1 AND invitee_id = $2 AND status = 'pending' AND expires_at > NOW()
+		RETURNING room_id
+	`
+	return r.acceptInvite(ctx, userID, query, inviteID, userID)
+}
+
+// AcceptRoomInvite accepts a user's pending invite to a room and adds them to
+// the room as a member. It returns ErrInviteNotFound if there is no such invite.
+func (r *Repository) AcceptRoomInvite(ctx context.Context, roomID, userID int64) error {
+	query := `
+		UPDATE room_invites SET status = 'accepted', responded_at = NOW()
+		WHERE room_id = $1 AND invitee_id = $2 AND status = 'pending' AND expires_at > NOW()
+		RETURNING room_id
+	`
+	_, err := r.acceptInvite(ctx, userID, query, roomID, userID)
+	return err
+}
+
+// acceptInvite runs an invite update and the membership insert in one transaction
+func (r *Repository) acceptInvite(ctx context.Context, userID int64, query string, args ...interface{}) (int64, error) {
+	tx, err := r.db.BeginTx(ctx, nil)
+	if err != nil {
+		return 0, err
+	}
+	defer tx.Rollback()
+
+	var roomID int64
+	err = tx.QueryRowContext(ctx, query, args...).Scan(&roomID)
+	if errors.Is(err, sql.ErrNoRows) {
+		return 0, ErrInviteNotFound
+	}
+	if err != nil {
+		return 0, err
+	}
+
+	err = addMember(ctx, tx, roomID, userID, "member")
+	if err != nil && !errors.Is(err, ErrAlreadyMember) {
+		return 0, err
+	}
+
+	return roomID, tx.Commit()
+}
+
+// DeclineInvite marks an invite as declined. It returns ErrInviteNotFound if
+// userID has no such pending invite.
+func (r *Repository) DeclineInvite(ctx context.Context, inviteID, userID int64) error {
+	query := `
+		UPDATE room_invites SET status = 'declined', responded_at = NOW()
+		WHERE id = $1 AND invitee_id = $2 AND status = 'pending'
+	`
+	result, err := r.db.ExecContext(ctx, query, inviteID, userID)
+	if err != nil {
+		return err
+	}
+	n, err := result.RowsAffected()
+	if err != nil {
+		return err
+	}
+	if n == 0 {
+		return ErrInviteNotFound
+	}
+	return nil
+}
@@ -0,0 +1,184 @@
+package room
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"time"
+)
+
+// Invite code errors
+var (
+	ErrInviteCodeNotFound  = errors.New("invite code not found")
+	ErrInviteCodeExpired   = errors.New("invite code has expired")
+	ErrInviteCodeRevoked   = errors.New("invite code has been revoked")
+	ErrInviteCodeExhausted = errors.New("invite code has reached its maximum number of uses")
+)
+
+// InviteCode represents a shareable room invite code
+type InviteCode struct {
+	ID        int64
+	Code      string
+	RoomID    int64
+	CreatorID int64
+	Role      string
+	MaxUses   int64 // 0 means no limit
+	Uses      int64
+	ExpiresAt sql.NullTime
+	RevokedAt sql.NullTime
+	CreatedAt time.Time
+}
+
+// InviteCodeUse records a user joining a room with an invite code
+type InviteCodeUse struct {
+	CodeID   int64
+	UserID   int64
+	Username string
+	UsedAt   time.Time
+}
+
+// inviteCodeColumns is the column list scanned by scanInviteCode
+const inviteCodeColumns = `id, code, room_id, creator_id, role, max_uses, uses, expires_at, revoked_at, created_at`
+
+// scanInviteCode scans a row selected with inviteCodeColumns
+func scanInviteCode(row interface{ Scan(...interface{}) error }) (*InviteCode, error) {
+	c := &InviteCode{}
+	err := row.Scan(&c.ID, &c.Code, &c.RoomID, &c.CreatorID, &c.Role, &c.MaxUses, &c.Uses,
+		&c.ExpiresAt, &c.RevokedAt, &c.CreatedAt)
+	return c, err
+}
+
+// CreateInviteCode creates an invite code for a room. A zero maxUses means no
+// limit and a zero expiresAt means the code never expires.
+func (r *Repository) CreateInviteCode(ctx context.Context, code string, roomID, creatorID int64, role string, maxUses int64, expiresAt time.Time) (*InviteCode, error) {
+	expires := sql.NullTime{Time: expiresAt, Valid: !expiresAt.IsZero()}
+	query := `
+		INSERT INTO room_invite_codes (code, room_id, creator_id, role, max_uses, expires_at)
+		VALUES ($1, $2, $3, $4, $5, $6)
+		RETURNING ` + inviteCodeColumns
+	return scanInviteCode(r.db.QueryRowContext(ctx, query, code, roomID, creatorID, role, maxUses, expires))
+}
+
+// GetRoomInviteCodes retrieves all invite codes of a room, newest first
+func (r *Repository) GetRoomInviteCodes(ctx context.Context, roomID int64) ([]*InviteCode, error) {
+	query := `SELECT ` + inviteCodeColumns + ` FROM room_invite_codes WHERE room_id = $1 ORDER BY created_at DESC`
+	rows, err := r.db.QueryContext(ctx, query, roomID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var codes []*InviteCode
+	for rows.Next() {
+		c, err := scanInviteCode(rows)
+		if err != nil {
+			return nil, err
+		}
+		codes = append(codes, c)
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+
+	return codes, nil
+}
+
+// GetRoomInviteCodeUses retrieves who joined a room through which invite code
+func (r *Repository) GetRoomInviteCodeUses(ctx context.Context, roomID int64) ([]InviteCodeUse, error) {
+	query := `
+		SELECT u.code_id, u.user_id, us.username, u.used_at
+		FROM room_invite_code_uses u
+		JOIN room_invite_codes c ON c.id = u.code_id
+		JOIN users us ON us.id = u.user_id
+		WHERE c.room_id = $1
+		ORDER BY u.used_at
+	`
+	rows, err := r.db.QueryContext(ctx, query, roomID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var uses []InviteCodeUse
+	for rows.Next() {
+		var use InviteCodeUse
+		if err := rows.Scan(&use.CodeID, &use.UserID, &use.Username, &use.UsedAt); err != nil {
+			return nil, err
+		}
+		uses = append(uses, use)
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+
+	return uses, nil
+}
+
+// RevokeInviteCode revokes an invite code of a room. It returns
+// ErrInviteCodeNotFound if the room has no such active code.
+func (r *Repository) RevokeInviteCode(ctx context.Context, roomID int64, code string) error {
+	query := `UPDATE room_invite_codes SET revoked_at = NOW() WHERE room_id = $1 AND code = $2 AND revoked_at IS NULL`
+	result, err := r.db.ExecContext(ctx, query, roomID, code)
+	if err != nil {
+		return err
+	}
+	n, err := result.RowsAffected()
+	if err != nil {
+		return err
+	}
+	if n == 0 {
+		return ErrInviteCodeNotFound
+	}
+	return nil
+}
+
+// RedeemInviteCode adds a user to the room of an invite code with the role
+// the code grants, counts the use and records who used it. The code row is
+// locked so concurrent redemptions cannot exceed the use limit.
+func (r *Repository) RedeemInviteCode(ctx context.Context, code string, userID int64) (*InviteCode, error) {
+	tx, err := r.db.BeginTx(ctx, nil)
+	if err != nil {
+		return nil, err
+	}
+	defer tx.Rollback()
+
+	c, err := scanInviteCode(tx.QueryRowContext(ctx,
+		`SELECT `+inviteCodeColumns+` FROM room_invite_codes WHERE code = $1 FOR UPDATE`, code))
+	if errors.Is(err, sql.ErrNoRows) {
+		return nil, ErrInviteCodeNotFound
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	switch {
+	case c.RevokedAt.Valid:
+		return nil, ErrInviteCodeRevoked
+	case c.ExpiresAt.Valid && !c.ExpiresAt.Time.After(time.Now()):
+		return nil, ErrInviteCodeExpired
+	case c.MaxUses > 0 && c.Uses >= c.MaxUses:
+		return nil, ErrInviteCodeExhausted
+	}
+
+	if err := addMember(ctx, tx, c.RoomID, userID, c.Role); err != nil {
+		return nil, err
+	}
+
+	_, err = tx.ExecContext(ctx, `UPDATE room_invite_codes SET uses = uses + 1 WHERE id = $1`, c.ID)
+	if err != nil {
+		return nil, err
+	}
+	_, err = tx.ExecContext(ctx,
+		`INSERT INTO room_invite_code_uses (code_id, user_id) VALUES ($1, $2)
+		ON CONFLICT (code_id, user_id) DO UPDATE SET used_at = NOW()`,
+		c.ID, userID,
+	)
+	if err != nil {
+		return nil, err
+	}
+	c.Uses++
+
+	return c, tx.Commit()
+}
@@ -0,0 +1,169 @@
+package room
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"time"
+)
+
+// ErrJoinRequestNotFound is returned when a join request does not exist or
+// is no longer pending
+var ErrJoinRequestNotFound = errors.New("join request not found")
+
+// JoinRequest represents a request to join a room that needs approval
+type JoinRequest struct {
+	ID        int64
+	RoomID    int64
+	RoomName  string
+	UserID    int64
+	Username  string
+	Message   string
+	Status    string
+	CreatedAt time.Time
+	DecidedAt sql.NullTime
+}
+
+// joinRequestColumns is the column list scanned by scanJoinRequest, for
+// room_join_requests aliased as j joined with rooms r and users u
+const joinRequestColumns = `j.id, j.room_id, r.name, j.user_id, u.username, j.message, j.status,
+	j.created_at, j.decided_at`
+
+// scanJoinRequest scans a row selected with joinRequestColumns
+func scanJoinRequest(row interface{ Scan(...interface{}) error }) (*JoinRequest, error) {
+	j := &JoinRequest{}
+	err := row.Scan(&j.ID, &j.RoomID, &j.RoomName, &j.UserID, &j.Username, &j.Message, &j.Status,
+		&j.CreatedAt, &j.DecidedAt)
+	return j, err
+}
+
+// CreateJoinRequest asks to join a room. A pending request by the same user
+// is updated with the new message instead.
+func (r *Repository) CreateJoinRequest(ctx context.Context, roomID, userID int64, message string) (int64, error) {
+	var requestID int64
+	query := `
+		INSERT INTO room_join_requests (room_id, user_id, message)
+		VALUES ($1, $2, $3)
+		ON CONFLICT (room_id, user_id) WHERE status = 'pending'
+		DO UPDATE SET message = EXCLUDED.message
+		RETURNING id
+	`
+	err := r.db.QueryRowContext(ctx, query, roomID, userID, message).Scan(&requestID)
+	return requestID, err
+}
+
+// GetJoinRequest retrieves a join request. It returns sql.ErrNoRows if the
+// request does not exist.
+func (r *Repository) GetJoinRequest(ctx context.Context, requestID int64) (*JoinRequest, error) {
+	query := `
+		SELECT ` + joinRequestColumns + `
+		FROM room_join_requests j
+		JOIN rooms r ON r.id = j.room_id
+		JOIN users u ON u.id = j.user_id
+		WHERE j.id = $1
+	`
+	return scanJoinRequest(r.db.QueryRowContext(ctx, query, requestID))
+}
+
+// GetRoomJoinRequests retrieves the pending join requests of a room, oldest first
+func (r *Repository) GetRoomJoinRequests(ctx context.Context, roomID int64) ([]JoinRequest, error) {
+	query := `
+		SELECT ` + joinRequestColumns + `
+		FROM room_join_requests j
+		JOIN rooms r ON r.id = j.room_id
+		JOIN users u ON u.id = j.user_id
+		WHERE j.room_id = $1 AND j.status = 'pending'
+		ORDER BY j.created_at
+	`
+	return r.queryJoinRequests(ctx, query, roomID)
+}
+
+// GetUserJoinRequests retrieves the join requests a user has made, newest first
+func (r *Repository) GetUserJoinRequests(ctx context.Context, userID int64) ([]JoinRequest, error) {
+	query := `
+		SELECT ` + joinRequestColumns + `
+		FROM room_join_requests j
+		JOIN rooms r ON r.id = j.room_id
+		JOIN users u ON u.id = j.user_id
+		WHERE j.user_id = $1 AND r.deleted_at IS NULL
+		ORDER BY j.created_at DESC
+	`
+	return r.queryJoinRequests(ctx, query, userID)
+}
+
+// queryJoinRequests runs a query selecting joinRequestColumns
+func (r *Repository) queryJoinRequests(ctx context.Context, query string, args ...interface{}) ([]JoinRequest, error) {
+	rows, err := r.db.QueryContext(ctx, query, args...)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var requests []JoinRequest
+	for rows.Next() {
+		j, err := scanJoinRequest(rows)
+		if err != nil {
+			return nil, err
+		}
+		requests = append(requests, *j)
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+
+	return requests, nil
+}
+
+// ApproveJoinRequest approves a pending join request and adds the requester
+// to the room as a member. It returns ErrJoinRequestNotFound if the request
+// is not pending.
+func (r *Repository) ApproveJoinRequest(ctx context.Context, requestID, deciderID int64) error {
+	tx, err := r.db.BeginTx(ctx, nil)
+	if err != nil {
+		return err
+	}
+	defer tx.Rollback()
+
+	var roomID, userID int64
+	query := `
+		UPDATE room_join_requests SET status = 'approved', decided_by = $2, decided_at = NOW()
+		WHERE id = $1 AND status = 'pending'
+		RETURNING room_id, user_id
+	`
+	err = tx.QueryRowContext(ctx, query, requestID, deciderID).Scan(&roomID, &userID)
+	if errors.Is(err, sql.ErrNoRows) {
+		return ErrJoinRequestNotFound
+	}
+	if err != nil {
+		return err
+	}
+
+	err = addMember(ctx, tx, roomID, userID, "member")
+	if err != nil && !errors.Is(err, ErrAlreadyMember) {
+		return err
+	}
+
+	return tx.Commit()
+}
+
+// RejectJoinRequest rejects a pending join request. It returns
+// ErrJoinRequestNotFound if the request is not pending.
+func (r *Repository) RejectJoinRequest(ctx context.Context, requestID, deciderID int64) error {
+	query := `
+		UPDATE room_join_requests SET status = 'rejected', decided_by = $2, decided_at = NOW()
+		WHERE id = $1 AND status = 'pending'
+	`
+	result, err := r.db.ExecContext(ctx, query, requestID, deciderID)
+	if err != nil {
+		return err
+	}
+	n, err := result.RowsAffected()
+	if err != nil {
+		return err
+	}
+	if n == 0 {
+		return ErrJoinRequestNotFound
+	}
+	return nil
+}
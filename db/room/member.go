@@ -0,0 +1,165 @@
+package room
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"time"
+
+	"grpc-messenger-core/db/postgres"
+)
+
+// Membership errors
+var (
+	ErrAlreadyMember = errors.New("user is already a member of the room")
+	ErrRoomFull      = errors.New("room has reached its member limit")
+	ErrNotJoinable   = errors.New("direct conversations cannot be joined")
+)
+
+// onlineWindow is how recently a user must have been seen to count as online.
+// Chat streams refresh last_seen_at every chat.PresenceHeartbeat.
+const onlineWindow = 90 * time.Second
+
+// Member represents a user's membership in a room
+type Member struct {
+	UserID   int64
+	Username string
+	Role     string
+	JoinedAt time.Time
+	Online   bool
+}
+
+// memberColumns is the column list scanned by scanMember, for room_members
+// aliased as rm joined with users aliased as u. $1 is the online cutoff.
+const memberColumns = `rm.user_id, u.username, rm.role, rm.joined_at,
+	COALESCE(u.last_seen_at > $1, FALSE)`
+
+// scanMember scans a row selected with memberColumns
+func scanMember(row interface{ Scan(...interface{}) error }) (*Member, error) {
+	m := &Member{}
+	err := row.Scan(&m.UserID, &m.Username, &m.Role, &m.JoinedAt, &m.Online)
+	return m, err
+}
+
+// GetMember retrieves a user's membership in a room. It returns
+// sql.ErrNoRows if the user is not a member.
+func (r *Repository) GetMember(ctx context.Context, roomID, userID int64) (*Member, error) {
+	query := `
+		SELECT ` + memberColumns + `
+		FROM room_members rm
+		JOIN users u ON u.id = rm.user_id
+		WHERE rm.room_id = $2 AND rm.user_id = $3
+	`
+	return scanMember(r.db.QueryRowContext(ctx, query, time.Now().Add(-onlineWindow), roomID, userID))
+}
+
+// ListRoomMembers retrieves the members of a room, most privileged first and
+// then in the order they joined
+func (r *Repository) ListRoomMembers(ctx context.Context, roomID, limit, offset int64) ([]Member, error) {
+	query := `
+		SELECT ` + memberColumns + `
+		FROM room_members rm
+		JOIN users u ON u.id = rm.user_id
+		WHERE rm.room_id = $2
+		ORDER BY CASE rm.role
+			WHEN 'owner' THEN 1 WHEN 'admin' THEN 2 WHEN 'moderator' THEN 3 ELSE 4
+		END, rm.joined_at, rm.user_id
+		LIMIT $3 OFFSET $4
+	`
+	rows, err := r.db.QueryContext(ctx, query, time.Now().Add(-onlineWindow), roomID, limit, offset)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var members []Member
+	for rows.Next() {
+		m, err := scanMember(rows)
+		if err != nil {
+			return nil, err
+		}
+		members = append(members, *m)
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+
+	return members, nil
+}
+
+// CountRoomMembers returns the number of members of a room
+func (r *Repository) CountRoomMembers(ctx context.Context, roomID int64) (int64, error) {
+	var count int64
+	query := `SELECT COUNT(*) FROM room_members WHERE room_id = $1`
+	err := r.db.QueryRowContext(ctx, query, roomID).Scan(&count)
+	return count, err
+}
+
+// addMember adds a user to a room within tx. Every way of joining a room goes
+// through here so bans and the member limit are always enforced, and nobody
+// can be added to a direct conversation. The room row is locked so concurrent
+// joins cannot exceed the limit.
+func addMember(ctx context.Context, tx *sql.Tx, roomID, userID int64, role string) error {
+	banned, err := isBanned(ctx, tx, roomID, userID)
+	if err != nil {
+		return err
+	}
+	if banned {
+		return ErrUserBanned
+	}
+
+	var maxMembers sql.NullInt64
+	var kind string
+	err = tx.QueryRowContext(ctx,
+		`SELECT max_members, kind FROM rooms WHERE id = $1 AND deleted_at IS NULL FOR UPDATE`,
+		roomID,
+	).Scan(&maxMembers, &kind)
+	if errors.Is(err, sql.ErrNoRows) {
+		return ErrRoomNotFound
+	}
+	if err != nil {
+		return err
+	}
+	if kind == RoomKindDirect {
+		return ErrNotJoinable
+	}
+
+	var isMember bool
+	err = tx.QueryRowContext(ctx,
+		`SELECT EXISTS(SELECT 1 FROM room_members WHERE room_id = $1 AND user_id = $2)`,
+		roomID, userID,
+	).Scan(&isMember)
+	if err != nil {
+		return err
+	}
+	if isMember {
+		return ErrAlreadyMember
+	}
+
+	if maxMembers.Valid {
+		var count int64
+		err = tx.QueryRowContext(ctx, `SELECT COUNT(*) FROM room_members WHERE room_id = $1`, roomID).Scan(&count)
+		if err != nil {
+			return err
+		}
+		if count >= maxMembers.Int64 {
+			return ErrRoomFull
+		}
+	}
+
+	_, err = tx.ExecContext(ctx,
+		`INSERT INTO room_members (room_id, user_id, role) VALUES ($1, $2, $3)`,
+		roomID, userID, role,
+	)
+	if err != nil {
+		return err
+	}
+
+	// Tell open streams on the room about the new member
+	return postgres.NotifyRoomEvent(ctx, tx, postgres.RoomEvent{
+		Type:   postgres.RoomEventMemberAdded,
+		RoomID: roomID,
+		UserID: userID,
+	})
+}
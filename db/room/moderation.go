@@ -0,0 +1,116 @@
+package room
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"time"
+
+	"grpc-messenger-core/db/postgres"
+)
+
+// ErrUserBanned is returned when a banned user is added to a room
+var ErrUserBanned = errors.New("user is banned from the room")
+
+// querier is implemented by *sql.DB and *sql.Tx
+type querier interface {
+	QueryRowContext(ctx context.Context, query string, args ...interface{}) *sql.Row
+}
+
+// isBanned checks if a user has an active ban in a room
+func isBanned(ctx context.Context, q querier, roomID, userID int64) (bool, error) {
+	var banned bool
+	query := `
+		SELECT EXISTS(
+			SELECT 1 FROM room_bans
+			WHERE room_id = $1 AND user_id = $2 AND (expires_at IS NULL OR expires_at > NOW())
+		)
+	`
+	err := q.QueryRowContext(ctx, query, roomID, userID).Scan(&banned)
+	return banned, err
+}
+
+// IsBanned checks if a user has an active ban in a room
+func (r *Repository) IsBanned(ctx context.Context, roomID, userID int64) (bool, error) {
+	return isBanned(ctx, r.db, roomID, userID)
+}
+
+// BanUser bans a user from a room and removes their membership. A zero
+// expiresAt makes the ban permanent. An existing ban is replaced.
+func (r *Repository) BanUser(ctx context.Context, roomID, userID, bannedBy int64, reason string, expiresAt time.Time) error {
+	tx, err := r.db.BeginTx(ctx, nil)
+	if err != nil {
+		return err
+	}
+	defer tx.Rollback()
+
+	expires := sql.NullTime{Time: expiresAt, Valid: !expiresAt.IsZero()}
+	_, err = tx.ExecContext(ctx, `
+		INSERT INTO room_bans (room_id, user_id, banned_by, reason, expires_at)
+		VALUES ($1, $2, $3, $4, $5)
+		ON CONFLICT (room_id, user_id)
+		DO UPDATE SET banned_by = EXCLUDED.banned_by, reason = EXCLUDED.reason,
+			expires_at = EXCLUDED.expires_at, created_at = NOW()
+	`, roomID, userID, bannedBy, reason, expires)
+	if err != nil {
+		return err
+	}
+
+	if err := removeRoomMember(ctx, tx, roomID, userID); err != nil {
+		return err
+	}
+
+	return tx.Commit()
+}
+
+// UnbanUser lifts a user's ban from a room. It reports whether a ban existed.
+func (r *Repository) UnbanUser(ctx context.Context, roomID, userID int64) (bool, error) {
+	query := `DELETE FROM room_bans WHERE room_id = $1 AND user_id = $2`
+	result, err := r.db.ExecContext(ctx, query, roomID, userID)
+	if err != nil {
+		return false, err
+	}
+	n, err := result.RowsAffected()
+	return n > 0, err
+}
+
+// MuteUser prevents a user from sending messages to a room. A zero expiresAt
+// mutes the user until they are unmuted. An existing mute is replaced.
+func (r *Repository) MuteUser(ctx context.Context, roomID, userID, mutedBy int64, reason string, expiresAt time.Time) error {
+	expires := sql.NullTime{Time: expiresAt, Valid: !expiresAt.IsZero()}
+	query := `
+		INSERT INTO room_mutes (room_id, user_id, muted_by, reason, expires_at)
+		VALUES ($1, $2, $3, $4, $5)
+		ON CONFLICT (room_id, user_id)
+		DO UPDATE SET muted_by = EXCLUDED.muted_by, reason = EXCLUDED.reason,
+			expires_at = EXCLUDED.expires_at, created_at = NOW()
+	`
+	_, err := r.db.ExecContext(ctx, query, roomID, userID, mutedBy, reason, expires)
+	return err
+}
+
+// UnmuteUser lifts a user's mute in a room. It reports whether a mute existed.
+func (r *Repository) UnmuteUser(ctx context.Context, roomID, userID int64) (bool, error) {
+	query := `DELETE FROM room_mutes WHERE room_id = $1 AND user_id = $2`
+	result, err := r.db.ExecContext(ctx, query, roomID, userID)
+	if err != nil {
+		return false, err
+	}
+	n, err := result.RowsAffected()
+	return n > 0, err
+}
+
+// removeRoomMember deletes a membership and tells every chat service
+// instance, so the user's open streams for the room are closed
+func removeRoomMember(ctx context.Context, tx *sql.Tx, roomID, userID int64) error {
+	_, err := tx.ExecContext(ctx, `DELETE FROM room_members WHERE room_id = $1 AND user_id = $2`, roomID, userID)
+	if err != nil {
+		return err
+	}
+
+	return postgres.NotifyRoomEvent(ctx, tx, postgres.RoomEvent{
+		Type:   postgres.RoomEventMemberRemoved,
+		RoomID: roomID,
+		UserID: userID,
+	})
+}
@@ -3,6 +3,28 @@ package room
 import (
 	"context"
 	"database/sql"
+	"errors"
+	"fmt"
+	"time"
+
+	"grpc-messenger-core/db/postgres"
+)
+
+// ErrRoomNotFound is returned when a room does not exist or has been deleted
+var ErrRoomNotFound = errors.New("room not found")
+
+// Join policies
+const (
+	JoinPolicyOpen       = "open"
+	JoinPolicyApproval   = "approval"
+	JoinPolicyInviteOnly = "invite_only"
+)
+
+// Room kinds
+const (
+	RoomKindRoom   = "room"
+	RoomKindDirect = "direct"
+	RoomKindGroup  = "group"
 )
 
 // Room represents a chat room in the database
@@ -11,6 +33,29 @@ type Room struct {
 	Name        string
 	Description string
 	CreatorID   int64
+	IsPrivate   bool
+	Topic       string
+	AvatarURL   string
+	Archived    bool
+	MaxMembers  int64 // 0 means no limit
+	JoinPolicy  string
+	Kind        string
+}
+
+// roomColumns is the column list scanned by scanRoom, for rooms aliased as r
+const roomColumns = `r.id, r.name, COALESCE(r.description, ''), r.creator_id, r.is_private,
+	r.topic, r.avatar_url, r.archived_at IS NOT NULL, COALESCE(r.max_members, 0), r.join_policy,
+	r.kind`
+
+// scanRoom scans a row selected with roomColumns, followed by any extra
+// columns into extra
+func scanRoom(row interface{ Scan(...interface{}) error }, extra ...interface{}) (*Room, error) {
+	room := &Room{}
+	dest := []interface{}{&room.ID, &room.Name, &room.Description, &room.CreatorID, &room.IsPrivate,
+		&room.Topic, &room.AvatarURL, &room.Archived, &room.MaxMembers, &room.JoinPolicy,
+		&room.Kind}
+	err := row.Scan(append(dest, extra...)...)
+	return room, err
 }
 
 // Repository handles database operations for rooms
@@ -23,29 +68,34 @@ func NewRepository(db *sql.DB) *Repository {
 	return &Repository{db: db}
 }
 
-// CreateRoom creates a new room in the database
-func (r *Repository) CreateRoom(ctx context.Context, name, description string, creatorID int64) (int64, error) {
+// CreateRoom creates a new room in the database from the room's name,
+// description, creator, privacy, member limit and join policy
+func (r *Repository) CreateRoom(ctx context.Context, room Room) (int64, error) {
 	var roomID int64
-	query := `INSERT INTO rooms (name, description, creator_id) VALUES ($1, $2, $3) RETURNING id`
-	err := r.db.QueryRowContext(ctx, query, name, description, creatorID).Scan(&roomID)
+	query := `
+		INSERT INTO rooms (name, description, creator_id, is_private, max_members, join_policy)
+		VALUES ($1, $2, $3, $4, NULLIF($5, 0), $6)
+		RETURNING id
+	`
+	err := r.db.QueryRowContext(ctx, query, room.Name, room.Description, room.CreatorID,
+		room.IsPrivate, room.MaxMembers, room.JoinPolicy).Scan(&roomID)
 	return roomID, err
 }
 
-// GetRoom retrieves a room by ID
+// GetRoom retrieves a room by ID. Deleted rooms are not returned.
 func (r *Repository) GetRoom(ctx context.Context, roomID int64) (*Room, error) {
-	room := &Room{}
-	query := `SELECT id, name, description, creator_id FROM rooms WHERE id = $1`
-	err := r.db.QueryRowContext(ctx, query, roomID).Scan(&room.ID, &room.Name, &room.Description, &room.CreatorID)
-	return room, err
+	query := `SELECT ` + roomColumns + ` FROM rooms r WHERE r.id = $1 AND r.deleted_at IS NULL`
+	return scanRoom(r.db.QueryRowContext(ctx, query, roomID))
 }
 
-// GetUserRooms retrieves all rooms a user is a member of
+// GetUserRooms retrieves all rooms a user is a member of, not counting
+// direct conversations
 func (r *Repository) GetUserRooms(ctx context.Context, userID int64) ([]Room, error) {
 	query := `
-		SELECT r.id, r.name, r.description, r.creator_id
+		SELECT ` + roomColumns + `
 		FROM rooms r
 		JOIN room_members rm ON r.id = rm.room_id
-		WHERE rm.user_id = $1
+		WHERE rm.user_id = $1 AND r.kind = 'room' AND r.deleted_at IS NULL
 	`
 	rows, err := r.db.QueryContext(ctx, query, userID)
 	if err != nil {
@@ -55,11 +105,11 @@ func (r *Repository) GetUserRooms(ctx context.Context, userID int64) ([]Room, er
 
 	var rooms []Room
 	for rows.Next() {
-		var room Room
-		if err := rows.Scan(&room.ID, &room.Name, &room.Description, &room.CreatorID); err != nil {
+		room, err := scanRoom(rows)
+		if err != nil {
 			return nil, err
 		}
-		rooms = append(rooms, room)
+		rooms = append(rooms, *room)
 	}
 
 	if err := rows.Err(); err != nil {
@@ -72,7 +122,7 @@ func (r *Repository) GetUserRooms(ctx context.Context, userID int64) ([]Room, er
 // RoomExists checks if a room with the given ID exists
 func (r *Repository) RoomExists(ctx context.Context, roomID int64) (bool, error) {
 	var exists bool
-	query := `SELECT EXISTS(SELECT 1 FROM rooms WHERE id = $1)`
+	query := `SELECT EXISTS(SELECT 1 FROM rooms WHERE id = $1 AND deleted_at IS NULL)`
 	err := r.db.QueryRowContext(ctx, query, roomID).Scan(&exists)
 	return exists, err
 }
@@ -85,16 +135,310 @@ func (r *Repository) IsRoomMember(ctx context.Context, roomID, userID int64) (bo
 	return exists, err
 }
 
-// AddRoomMember adds a user to a room
-func (r *Repository) AddRoomMember(ctx context.Context, roomID, userID int64) error {
-	query := `INSERT INTO room_members (room_id, user_id) VALUES ($1, $2) ON CONFLICT DO NOTHING`
-	_, err := r.db.ExecContext(ctx, query, roomID, userID)
+// AddRoomMember adds a user to a room with the given role. It returns
+// ErrUserBanned if the user is banned from the room, ErrRoomFull if the room
+// has reached its member limit and ErrAlreadyMember if the user already
+// belongs to it.
+func (r *Repository) AddRoomMember(ctx context.Context, roomID, userID int64, role string) error {
+	tx, err := r.db.BeginTx(ctx, nil)
+	if err != nil {
+		return err
+	}
+	defer tx.Rollback()
+
+	if err := addMember(ctx, tx, roomID, userID, role); err != nil {
+		return err
+	}
+
+	return tx.Commit()
+}
+
+// GetMemberRole retrieves a member's role in a room. It returns
+// sql.ErrNoRows if the user is not a member or the room has been deleted.
+func (r *Repository) GetMemberRole(ctx context.Context, roomID, userID int64) (string, error) {
+	var role string
+	query := `
+		SELECT rm.role FROM room_members rm
+		JOIN rooms r ON r.id = rm.room_id
+		WHERE rm.room_id = $1 AND rm.user_id = $2 AND r.deleted_at IS NULL
+	`
+	err := r.db.QueryRowContext(ctx, query, roomID, userID).Scan(&role)
+	return role, err
+}
+
+// SetMemberRole changes a member's role in a room
+func (r *Repository) SetMemberRole(ctx context.Context, roomID, userID int64, role string) error {
+	query := `UPDATE room_members SET role = $3 WHERE room_id = $1 AND user_id = $2`
+	_, err := r.db.ExecContext(ctx, query, roomID, userID, role)
 	return err
 }
 
-// RemoveRoomMember removes a user from a room
+// Ownership transfer errors
+var (
+	ErrNotOwner       = errors.New("user does not own the room")
+	ErrNewOwnerAbsent = errors.New("new owner is not a member of the room")
+)
+
+// TransferOwnership makes newOwnerID the owner of a room and demotes the
+// current owner to admin. Both memberships are locked and checked within the
+// transaction, so concurrent role changes cannot leave the room with no owner
+// or two. It returns ErrNotOwner if ownerID does not own the room and
+// ErrNewOwnerAbsent if newOwnerID is not a member.
+func (r *Repository) TransferOwnership(ctx context.Context, roomID, ownerID, newOwnerID int64) error {
+	tx, err := r.db.BeginTx(ctx, nil)
+	if err != nil {
+		return err
+	}
+	defer tx.Rollback()
+
+	rows, err := tx.QueryContext(ctx, `
+		SELECT user_id, role FROM room_members
+		WHERE room_id = $1 AND user_id IN ($2, $3)
+		ORDER BY user_id
+		FOR UPDATE
+	`, roomID, ownerID, newOwnerID)
+	if err != nil {
+		return err
+	}
+	roles := make(map[int64]string)
+	for rows.Next() {
+		var userID int64
+		var role string
+		if err := rows.Scan(&userID, &role); err != nil {
+			rows.Close()
+			return err
+		}
+		roles[userID] = role
+	}
+	rows.Close()
+	if err := rows.Err(); err != nil {
+		return err
+	}
+
+	if roles[ownerID] != "owner" {
+		return ErrNotOwner
+	}
+	if _, ok := roles[newOwnerID]; !ok {
+		return ErrNewOwnerAbsent
+	}
+
+	updates := []struct {
+		userID int64
+		role   string
+	}{
+		{ownerID, "admin"},
+		{newOwnerID, "owner"},
+	}
+	for _, u := range updates {
+		result, err := tx.ExecContext(ctx, `UPDATE room_members SET role = $3 WHERE room_id = $1 AND user_id = $2`, roomID, u.userID, u.role)
+		if err != nil {
+			return err
+		}
+		if n, err := result.RowsAffected(); err != nil {
+			return err
+		} else if n != 1 {
+			return fmt.Errorf("updated %d memberships of user %d, want 1", n, u.userID)
+		}
+	}
+
+	return tx.Commit()
+}
+
+// RemoveRoomMember removes a user from a room and closes their open streams
+// for it
 func (r *Repository) RemoveRoomMember(ctx context.Context, roomID, userID int64) error {
-	query := `DELETE FROM room_members WHERE room_id = $1 AND user_id = $2`
-	_, err := r.db.ExecContext(ctx, query, roomID, userID)
-	return err
+	tx, err := r.db.BeginTx(ctx, nil)
+	if err != nil {
+		return err
+	}
+	defer tx.Rollback()
+
+	if err := removeRoomMember(ctx, tx, roomID, userID); err != nil {
+		return err
+	}
+
+	return tx.Commit()
+}
+
+// RoomUpdate holds the room fields to change. Nil fields are left unchanged.
+type RoomUpdate struct {
+	Name        *string
+	Description *string
+	Topic       *string
+	AvatarURL   *string
+	MaxMembers  *int64 // 0 removes the limit
+	JoinPolicy  *string
+}
+
+// UpdateRoom changes a room's details and returns the updated room
+func (r *Repository) UpdateRoom(ctx context.Context, roomID int64, update RoomUpdate) (*Room, error) {
+	tx, err := r.db.BeginTx(ctx, nil)
+	if err != nil {
+		return nil, err
+	}
+	defer tx.Rollback()
+
+	query := `
+		UPDATE rooms r SET
+			name = COALESCE($2, r.name),
+			description = COALESCE($3, r.description),
+			topic = COALESCE($4, r.topic),
+			avatar_url = COALESCE($5, r.avatar_url),
+			max_members = CASE WHEN $6::integer IS NULL THEN r.max_members ELSE NULLIF($6, 0) END,
+			join_policy = COALESCE($7, r.join_policy)
+		WHERE r.id = $1 AND r.deleted_at IS NULL
+		RETURNING ` + roomColumns
+	room, err := scanRoom(tx.QueryRowContext(ctx, query, roomID,
+		update.Name, update.Description, update.Topic, update.AvatarURL, update.MaxMembers, update.JoinPolicy))
+	if err != nil {
+		return nil, err
+	}
+
+	if err := notifyRoomUpdated(ctx, tx, roomID); err != nil {
+		return nil, err
+	}
+
+	return room, tx.Commit()
+}
+
+// SetRoomArchived archives or unarchives a room. It returns ErrRoomNotFound
+// if the room does not exist or has been deleted.
+func (r *Repository) SetRoomArchived(ctx context.Context, roomID int64, archived bool) error {
+	tx, err := r.db.BeginTx(ctx, nil)
+	if err != nil {
+		return err
+	}
+	defer tx.Rollback()
+
+	query := `UPDATE rooms SET archived_at = NULL WHERE id = $1 AND deleted_at IS NULL`
+	if archived {
+		query = `UPDATE rooms SET archived_at = COALESCE(archived_at, NOW()) WHERE id = $1 AND deleted_at IS NULL`
+	}
+	result, err := tx.ExecContext(ctx, query, roomID)
+	if err != nil {
+		return err
+	}
+	if n, err := result.RowsAffected(); err != nil {
+		return err
+	} else if n == 0 {
+		return ErrRoomNotFound
+	}
+
+	if err := notifyRoomUpdated(ctx, tx, roomID); err != nil {
+		return err
+	}
+
+	return tx.Commit()
+}
+
+// SoftDeleteRoom marks a room as deleted. Its messages and members are kept
+// until PurgeDeletedRooms removes them, but are no longer reachable.
+func (r *Repository) SoftDeleteRoom(ctx context.Context, roomID int64) error {
+	tx, err := r.db.BeginTx(ctx, nil)
+	if err != nil {
+		return err
+	}
+	defer tx.Rollback()
+
+	_, err = tx.ExecContext(ctx, `UPDATE rooms SET deleted_at = NOW() WHERE id = $1 AND deleted_at IS NULL`, roomID)
+	if err != nil {
+		return err
+	}
+
+	if err := notifyRoomDeleted(ctx, tx, roomID); err != nil {
+		return err
+	}
+
+	return tx.Commit()
+}
+
+// DeleteRoom permanently deletes a room with its messages and members
+func (r *Repository) DeleteRoom(ctx context.Context, roomID int64) error {
+	tx, err := r.db.BeginTx(ctx, nil)
+	if err != nil {
+		return err
+	}
+	defer tx.Rollback()
+
+	if err := deleteRoom(ctx, tx, roomID); err != nil {
+		return err
+	}
+
+	if err := notifyRoomDeleted(ctx, tx, roomID); err != nil {
+		return err
+	}
+
+	return tx.Commit()
+}
+
+// PurgeDeletedRooms permanently deletes rooms that were soft-deleted more
+// than retention ago and returns how many were purged
+func (r *Repository) PurgeDeletedRooms(ctx context.Context, retention time.Duration) (int, error) {
+	tx, err := r.db.BeginTx(ctx, nil)
+	if err != nil {
+		return 0, err
+	}
+	defer tx.Rollback()
+
+	rows, err := tx.QueryContext(ctx,
+		`SELECT id FROM rooms WHERE deleted_at < $1 FOR UPDATE SKIP LOCKED`,
+		time.Now().Add(-retention),
+	)
+	if err != nil {
+		return 0, err
+	}
+	var roomIDs []int64
+	for rows.Next() {
+		var id int64
+		if err := rows.Scan(&id); err != nil {
+			rows.Close()
+			return 0, err
+		}
+		roomIDs = append(roomIDs, id)
+	}
+	rows.Close()
+	if err := rows.Err(); err != nil {
+		return 0, err
+	}
+
+	for _, id := range roomIDs {
+		if err := deleteRoom(ctx, tx, id); err != nil {
+			return 0, err
+		}
+	}
+
+	return len(roomIDs), tx.Commit()
+}
+
+// deleteRoom deletes a room and the rows referencing it. Tables created with
+// ON DELETE CASCADE are cleaned up by the database.
+func deleteRoom(ctx context.Context, tx *sql.Tx, roomID int64) error {
+	for _, query := range []string{
+		`DELETE FROM messages WHERE room_id = $1`,
+		`DELETE FROM room_members WHERE room_id = $1`,
+		`DELETE FROM rooms WHERE id = $1`,
+	} {
+		if _, err := tx.ExecContext(ctx, query, roomID); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// notifyRoomUpdated tells every chat service instance that a room's details
+// changed, so open streams on it can show them
+func notifyRoomUpdated(ctx context.Context, tx *sql.Tx, roomID int64) error {
+	return postgres.NotifyRoomEvent(ctx, tx, postgres.RoomEvent{
+		Type:   postgres.RoomEventRoomUpdated,
+		RoomID: roomID,
+	})
+}
+
+// notifyRoomDeleted tells every chat service instance to end the open
+// streams of a deleted room
+func notifyRoomDeleted(ctx context.Context, tx *sql.Tx, roomID int64) error {
+	return postgres.NotifyRoomEvent(ctx, tx, postgres.RoomEvent{
+		Type:   postgres.RoomEventRoomDeleted,
+		RoomID: roomID,
+	})
 }
@@ -0,0 +1,238 @@
+package auth
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"log"
+	"time"
+
+	"grpc-messenger-core/db/auth"
+	"grpc-messenger-core/internal/middleware"
+	pb "grpc-messenger-core/proto/auth"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// Default and maximum page size for ListUsers
+const (
+	defaultListUsersLimit = 50
+	maxListUsersLimit     = 500
+)
+
+// AdminService implements the AdminService gRPC service. Role checks are
+// enforced by the auth interceptor.
+type AdminService struct {
+	pb.UnimplementedAdminServiceServer
+	db          *sql.DB
+	logger      *log.Logger
+	repo        *auth.Repository
+	revocations *middleware.RevocationChecker
+}
+
+// NewAdminService creates a new admin service
+func NewAdminService(db *sql.DB, logger *log.Logger, revocations *middleware.RevocationChecker) *AdminService {
+	return &AdminService{
+		db:          db,
+		logger:      logger,
+		repo:        auth.NewRepository(db),
+		revocations: revocations,
+	}
+}
+
+// ListUsers lists user accounts
+func (s *AdminService) ListUsers(ctx context.Context, req *pb.ListUsersRequest) (*pb.ListUsersResponse, error) {
+	if s.db == nil {
+		return nil, status.Error(codes.Unavailable, "database is not available")
+	}
+
+	// Set default values for limit and offset if not provided
+	limit := req.Limit
+	if limit <= 0 {
+		limit = defaultListUsersLimit
+	}
+	if limit > maxListUsersLimit {
+		limit = maxListUsersLimit
+	}
+	offset := req.Offset
+	if offset < 0 {
+		offset = 0
+	}
+
+	users, err := s.repo.ListUsers(ctx, req.Query, limit, offset)
+	if err != nil {
+		s.logger.Printf("Error listing users: %v", err)
+		return nil, status.Error(codes.Internal, "failed to list users")
+	}
+
+	pbUsers := make([]*pb.UserInfo, 0, len(users))
+	for _, u := range users {
+		pbUsers = append(pbUsers, &pb.UserInfo{
+			Id:        u.ID,
+			Username:  u.Username,
+			Role:      u.Role,
+			Disabled:  u.Disabled,
+			CreatedAt: u.CreatedAt.Format(time.RFC3339),
+		})
+	}
+
+	return &pb.ListUsersResponse{
+		Users: pbUsers,
+	}, nil
+}
+
+// SetUserRole changes a user's site role and ends their sessions so the new
+// role takes effect immediately
+func (s *AdminService) SetUserRole(ctx context.Context, req *pb.SetUserRoleRequest) (*pb.SetUserRoleResponse, error) {
+	role := middleware.Role(req.Role)
+	if !role.Valid() {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid role %q", req.Role)
+	}
+
+	if err := s.checkTarget(ctx, req.UserId); err != nil {
+		return nil, err
+	}
+
+	s.logger.Printf("Setting role of user %d to %s", req.UserId, role)
+	if err := s.repo.SetUserRole(ctx, req.UserId, string(role)); err != nil {
+		s.logger.Printf("Error setting user role: %v", err)
+		return nil, status.Error(codes.Internal, "failed to set user role")
+	}
+
+	if err := s.endSessions(ctx, req.UserId); err != nil {
+		return nil, err
+	}
+
+	return &pb.SetUserRoleResponse{
+		Success: true,
+		Message: "user role updated successfully",
+	}, nil
+}
+
+// DisableUser disables a user account and ends its sessions
+func (s *AdminService) DisableUser(ctx context.Context, req *pb.DisableUserRequest) (*pb.DisableUserResponse, error) {
+	if err := s.checkTarget(ctx, req.UserId); err != nil {
+		return nil, err
+	}
+
+	s.logger.Printf("Disabling user %d", req.UserId)
+	if err := s.repo.SetUserDisabled(ctx, req.UserId, true); err != nil {
+		s.logger.Printf("Error disabling user: %v", err)
+		return nil, status.Error(codes.Internal, "failed to disable user")
+	}
+
+	if err := s.endSessions(ctx, req.UserId); err != nil {
+		return nil, err
+	}
+
+	return &pb.DisableUserResponse{
+		Success: true,
+		Message: "user disabled successfully",
+	}, nil
+}
+
+// EnableUser re-enables a disabled user account
+func (s *AdminService) EnableUser(ctx context.Context, req *pb.EnableUserRequest) (*pb.EnableUserResponse, error) {
+	if err := s.checkTarget(ctx, req.UserId); err != nil {
+		return nil, err
+	}
+
+	s.logger.Printf("Enabling user %d", req.UserId)
+	if err := s.repo.SetUserDisabled(ctx, req.UserId, false); err != nil {
+		s.logger.Printf("Error enabling user: %v", err)
+		return nil, status.Error(codes.Internal, "failed to enable user")
+	}
+
+	return &pb.EnableUserResponse{
+		Success: true,
+		Message: "user enabled successfully",
+	}, nil
+}
+
+// ResetUserPassword sets a new password for a user and ends their sessions
+func (s *AdminService) ResetUserPassword(ctx context.Context, req *pb.ResetUserPasswordRequest) (*pb.ResetUserPasswordResponse, error) {
+	if err := s.checkTarget(ctx, req.UserId); err != nil {
+		return nil, err
+	}
+
+	// Generate a temporary password if none was given
+	password := req.NewPassword
+	var temporaryPassword string
+	if password == "" {
+		var err error
+		temporaryPassword, err = randomHex(12)
+		if err != nil {
+			s.logger.Printf("Error generating temporary password: %v", err)
+			return nil, status.Error(codes.Internal, "failed to generate temporary password")
+		}
+		password = temporaryPassword
+	}
+
+	hashedPassword, err := middleware.HashPassword(password)
+	if err != nil {
+		s.logger.Printf("Error hashing password: %v", err)
+		return nil, status.Error(codes.Internal, "failed to hash password")
+	}
+
+	s.logger.Printf("Resetting password of user %d", req.UserId)
+	if err := s.repo.UpdatePasswordHash(ctx, req.UserId, hashedPassword); err != nil {
+		s.logger.Printf("Error updating password: %v", err)
+		return nil, status.Error(codes.Internal, "failed to reset password")
+	}
+
+	if err := s.endSessions(ctx, req.UserId); err != nil {
+		return nil, err
+	}
+
+	return &pb.ResetUserPasswordResponse{
+		Success:           true,
+		Message:           "password reset successfully",
+		TemporaryPassword: temporaryPassword,
+	}, nil
+}
+
+// checkTarget checks that a user can be managed by the caller. Callers can't
+// manage their own account, so an admin can't lock themselves out, and only
+// admins can manage users with a role at least as high as their own.
+func (s *AdminService) checkTarget(ctx context.Context, userID int64) error {
+	if s.db == nil {
+		return status.Error(codes.Unavailable, "database is not available")
+	}
+
+	claims, err := middleware.ClaimsFromContext(ctx)
+	if err != nil {
+		return err
+	}
+	if userID == claims.UserID {
+		return status.Error(codes.InvalidArgument, "cannot manage your own account")
+	}
+
+	user, err := s.repo.GetUserByID(ctx, userID)
+	if errors.Is(err, sql.ErrNoRows) {
+		return status.Error(codes.NotFound, "user not found")
+	}
+	if err != nil {
+		s.logger.Printf("Error getting user: %v", err)
+		return status.Error(codes.Internal, "failed to get user")
+	}
+
+	if !claims.Role.AtLeast(middleware.RoleAdmin) && middleware.Role(user.Role).AtLeast(claims.Role) {
+		return status.Error(codes.PermissionDenied, "cannot manage a user with an equal or higher role")
+	}
+
+	return nil
+}
+
+// endSessions revokes all of a user's tokens
+func (s *AdminService) endSessions(ctx context.Context, userID int64) error {
+	version, err := s.repo.RevokeAllUserTokens(ctx, userID)
+	if err != nil {
+		s.logger.Printf("Error revoking tokens: %v", err)
+		return status.Error(codes.Internal, "failed to revoke tokens")
+	}
+	if s.revocations != nil {
+		s.revocations.SetTokenVersion(userID, version)
+	}
+	return nil
+}
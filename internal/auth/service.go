@@ -2,8 +2,12 @@ package auth
 
 import (
 	"context"
+	"crypto/rand"
 	"database/sql"
+	"encoding/hex"
+	"errors"
 	"log"
+	"time"
 
 	"grpc-messenger-core/db/auth"
 	"grpc-messenger-core/internal/middleware"
@@ -16,18 +20,39 @@ import (
 // AuthService implements the AuthService gRPC service
 type AuthService struct {
 	pb.UnimplementedAuthServiceServer
-	db     *sql.DB
-	logger *log.Logger
-	repo   *auth.Repository
+	db      *sql.DB
+	logger  *log.Logger
+	repo    *auth.Repository
+	devMode bool // Allow mock responses when there is no database connection
+
+	revocations *middleware.RevocationChecker
 }
 
-// NewAuthService creates a new auth service
-func NewAuthService(db *sql.DB, logger *log.Logger) *AuthService {
+// NewAuthService creates a new auth service. Mock registration and login are
+// only served when devMode is set and no database connection is available.
+// Revocations made through Logout are recorded in the revocations cache,
+// which may be nil when there is no database.
+func NewAuthService(db *sql.DB, logger *log.Logger, devMode bool, revocations *middleware.RevocationChecker) *AuthService {
 	return &AuthService{
-		db:     db,
-		logger: logger,
-		repo:   auth.NewRepository(db),
+		db:          db,
+		logger:      logger,
+		repo:        auth.NewRepository(db),
+		devMode:     devMode,
+		revocations: revocations,
+	}
+}
+
+// mockMode reports whether requests should be served with mock data
+func (s *AuthService) mockMode() bool {
+	return s.db == nil && s.devMode
+}
+
+// checkDatabase returns an error if there is no database to serve the request
+func (s *AuthService) checkDatabase() error {
+	if s.db == nil {
+		return status.Error(codes.Unavailable, "database is not available")
 	}
+	return nil
 }
 
 // Register creates a new user account
@@ -39,9 +64,9 @@ func (s *AuthService) Register(ctx context.Context, req *pb.RegisterRequest) (*p
 		return nil, status.Error(codes.InvalidArgument, "username and password are required")
 	}
 
-	// Check if we're in mock mode (no database connection)
-	if s.db == nil {
-		s.logger.Printf("Using mock registration for testing")
+	// Check if we're in mock mode (development only, no database connection)
+	if s.mockMode() {
+		s.logger.Printf("Using mock registration for development")
 
 		// Generate a mock user ID based on the username
 		userID := int64(len(req.Username))
@@ -52,6 +77,9 @@ func (s *AuthService) Register(ctx context.Context, req *pb.RegisterRequest) (*p
 			UserId:  userID,
 		}, nil
 	}
+	if err := s.checkDatabase(); err != nil {
+		return nil, err
+	}
 
 	// Check if user already exists
 	exists, err := s.repo.UserExists(ctx, req.Username)
@@ -96,26 +124,251 @@ func (s *AuthService) Login(ctx context.Context, req *pb.LoginRequest) (*pb.Logi
 		return nil, status.Error(codes.InvalidArgument, "username and password are required")
 	}
 
-	// MOCK AUTHENTICATION FOR TESTING
-	// In a real implementation, we would check the database
-	s.logger.Printf("Using mock authentication for testing")
+	// Check if we're in mock mode (development only, no database connection)
+	if s.mockMode() {
+		s.logger.Printf("Using mock authentication for development")
+
+		// Generate a mock user ID based on the username
+		userID := int64(len(req.Username))
 
-	// Generate a mock user ID based on the username
-	userID := int64(len(req.Username))
+		token, expiresAt, err := middleware.GenerateToken(userID, req.Username, middleware.RoleUser, 0)
+		if err != nil {
+			s.logger.Printf("Error generating token: %v", err)
+			return nil, status.Error(codes.Internal, "failed to generate token")
+		}
+
+		return &pb.LoginResponse{
+			Success:   true,
+			Message:   "login successful (mock)",
+			Token:     token,
+			UserId:    userID,
+			Username:  req.Username,
+			ExpiresAt: expiresAt.Unix(),
+			Role:      string(middleware.RoleUser),
+		}, nil
+	}
+	if err := s.checkDatabase(); err != nil {
+		return nil, err
+	}
+
+	// Look up the user
+	user, err := s.repo.GetUserByUsername(ctx, req.Username)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, status.Error(codes.NotFound, "user not found")
+		}
+		s.logger.Printf("Error getting user: %v", err)
+		return nil, status.Error(codes.Internal, "failed to get user")
+	}
+
+	// Check password
+	if !middleware.CheckPasswordHash(req.Password, user.PasswordHash) {
+		return nil, status.Error(codes.Unauthenticated, "invalid password")
+	}
+
+	// Check if the account is disabled
+	if user.Disabled {
+		return nil, status.Error(codes.PermissionDenied, "account is disabled")
+	}
 
-	// Generate token
-	token, err := middleware.GenerateToken(userID, req.Username)
+	// Generate access token
+	token, expiresAt, err := middleware.GenerateToken(user.ID, user.Username, middleware.Role(user.Role), user.TokenVersion)
 	if err != nil {
 		s.logger.Printf("Error generating token: %v", err)
 		return nil, status.Error(codes.Internal, "failed to generate token")
 	}
 
+	// Generate refresh token, starting a new token family
+	refreshToken, refreshTokenHash, err := middleware.GenerateRefreshToken()
+	if err != nil {
+		s.logger.Printf("Error generating refresh token: %v", err)
+		return nil, status.Error(codes.Internal, "failed to generate refresh token")
+	}
+	familyID, err := randomHex(16)
+	if err != nil {
+		s.logger.Printf("Error generating token family: %v", err)
+		return nil, status.Error(codes.Internal, "failed to generate refresh token")
+	}
+	err = s.repo.CreateRefreshToken(ctx, user.ID, refreshTokenHash, familyID, time.Now().Add(middleware.RefreshTokenExpiration))
+	if err != nil {
+		s.logger.Printf("Error saving refresh token: %v", err)
+		return nil, status.Error(codes.Internal, "failed to save refresh token")
+	}
+
 	return &pb.LoginResponse{
-		Success:  true,
-		Message:  "login successful (mock)",
-		Token:    token,
-		UserId:   userID,
-		Username: req.Username,
+		Success:      true,
+		Message:      "login successful",
+		Token:        token,
+		UserId:       user.ID,
+		Username:     user.Username,
+		RefreshToken: refreshToken,
+		ExpiresAt:    expiresAt.Unix(),
+		Role:         user.Role,
+	}, nil
+}
+
+// RefreshToken exchanges a refresh token for a new access and refresh token pair.
+// Each refresh token can only be used once.
+func (s *AuthService) RefreshToken(ctx context.Context, req *pb.RefreshTokenRequest) (*pb.RefreshTokenResponse, error) {
+	s.logger.Println("RefreshToken request")
+
+	// Validate request
+	if req.RefreshToken == "" {
+		return nil, status.Error(codes.InvalidArgument, "refresh token is required")
+	}
+	if err := s.checkDatabase(); err != nil {
+		return nil, err
+	}
+
+	// Generate the replacement refresh token
+	refreshToken, refreshTokenHash, err := middleware.GenerateRefreshToken()
+	if err != nil {
+		s.logger.Printf("Error generating refresh token: %v", err)
+		return nil, status.Error(codes.Internal, "failed to generate refresh token")
+	}
+
+	// Rotate the presented token
+	old, err := s.repo.RotateRefreshToken(
+		ctx,
+		middleware.HashRefreshToken(req.RefreshToken),
+		refreshTokenHash,
+		time.Now().Add(middleware.RefreshTokenExpiration),
+	)
+	switch {
+	case errors.Is(err, auth.ErrRefreshTokenReused):
+		s.logger.Printf("Refresh token reuse detected, token family revoked")
+		return nil, status.Error(codes.Unauthenticated, "refresh token has already been used")
+	case errors.Is(err, auth.ErrRefreshTokenNotFound),
+		errors.Is(err, auth.ErrRefreshTokenExpired),
+		errors.Is(err, auth.ErrRefreshTokenRevoked):
+		return nil, status.Error(codes.Unauthenticated, err.Error())
+	case err != nil:
+		s.logger.Printf("Error rotating refresh token: %v", err)
+		return nil, status.Error(codes.Internal, "failed to refresh token")
+	}
+
+	// Look up the user for the new access token
+	user, err := s.repo.GetUserByID(ctx, old.UserID)
+	if err != nil {
+		s.logger.Printf("Error getting user: %v", err)
+		return nil, status.Error(codes.Internal, "failed to get user")
+	}
+	if user.Disabled {
+		return nil, status.Error(codes.PermissionDenied, "account is disabled")
+	}
+
+	// Generate access token
+	token, expiresAt, err := middleware.GenerateToken(user.ID, user.Username, middleware.Role(user.Role), user.TokenVersion)
+	if err != nil {
+		s.logger.Printf("Error generating token: %v", err)
+		return nil, status.Error(codes.Internal, "failed to generate token")
+	}
+
+	return &pb.RefreshTokenResponse{
+		Success:      true,
+		Message:      "token refreshed successfully",
+		Token:        token,
+		RefreshToken: refreshToken,
+		ExpiresAt:    expiresAt.Unix(),
+		UserId:       user.ID,
+		Username:     user.Username,
+		Role:         user.Role,
+	}, nil
+}
+
+// GetJWKS returns the public keys used to verify access tokens
+func (s *AuthService) GetJWKS(ctx context.Context, req *pb.GetJWKSRequest) (*pb.GetJWKSResponse, error) {
+	keys := middleware.PublicKeys()
+
+	pbKeys := make([]*pb.JWK, 0, len(keys))
+	for _, k := range keys {
+		jwk := &pb.JWK{
+			Kty: k.Kty,
+			Kid: k.Kid,
+			Use: k.Use,
+			Alg: k.Alg,
+		}
+		if k.Kty == "RSA" {
+			jwk.N = &k.N
+			jwk.E = &k.E
+		} else {
+			jwk.Crv = &k.Crv
+			jwk.X = &k.X
+			jwk.Y = &k.Y
+		}
+		pbKeys = append(pbKeys, jwk)
+	}
+
+	return &pb.GetJWKSResponse{
+		Keys: pbKeys,
+	}, nil
+}
+
+// Logout revokes the caller's access token and, if given, its refresh token
+func (s *AuthService) Logout(ctx context.Context, req *pb.LogoutRequest) (*pb.LogoutResponse, error) {
+	s.logger.Println("Logout request")
+
+	if err := s.checkDatabase(); err != nil {
+		return nil, err
+	}
+
+	// Get the authenticated user
+	claims, err := middleware.ClaimsFromContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	// Revoke the access token
+	if claims.ID == "" || claims.ExpiresAt == nil {
+		return nil, status.Error(codes.InvalidArgument, "token cannot be revoked")
+	}
+	err = s.repo.RevokeToken(ctx, claims.ID, claims.UserID, claims.ExpiresAt.Time)
+	if err != nil {
+		s.logger.Printf("Error revoking token: %v", err)
+		return nil, status.Error(codes.Internal, "failed to revoke token")
+	}
+	s.revocations.Revoke(claims.ID, claims.ExpiresAt.Time)
+
+	// Revoke the refresh token family
+	if req.RefreshToken != "" {
+		err = s.repo.RevokeRefreshTokenFamily(ctx, claims.UserID, middleware.HashRefreshToken(req.RefreshToken))
+		if err != nil {
+			s.logger.Printf("Error revoking refresh token: %v", err)
+			return nil, status.Error(codes.Internal, "failed to revoke refresh token")
+		}
+	}
+
+	return &pb.LogoutResponse{
+		Success: true,
+		Message: "logged out successfully",
+	}, nil
+}
+
+// LogoutAll revokes every access and refresh token issued to the caller
+func (s *AuthService) LogoutAll(ctx context.Context, req *pb.LogoutAllRequest) (*pb.LogoutAllResponse, error) {
+	s.logger.Println("LogoutAll request")
+
+	if err := s.checkDatabase(); err != nil {
+		return nil, err
+	}
+
+	// Get the authenticated user
+	claims, err := middleware.ClaimsFromContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	// Revoke all tokens
+	version, err := s.repo.RevokeAllUserTokens(ctx, claims.UserID)
+	if err != nil {
+		s.logger.Printf("Error revoking tokens: %v", err)
+		return nil, status.Error(codes.Internal, "failed to revoke tokens")
+	}
+	s.revocations.SetTokenVersion(claims.UserID, version)
+
+	return &pb.LogoutAllResponse{
+		Success: true,
+		Message: "logged out of all sessions successfully",
 	}, nil
 }
 
@@ -137,11 +390,36 @@ func (s *AuthService) ValidateToken(ctx context.Context, req *pb.ValidateTokenRe
 		}, nil
 	}
 
+	// Check if the token has been revoked
+	if s.revocations != nil {
+		err := s.revocations.Check(ctx, claims)
+		if errors.Is(err, middleware.ErrTokenRevoked) {
+			return &pb.ValidateTokenResponse{
+				Valid:   false,
+				Message: "token has been revoked",
+			}, nil
+		}
+		if err != nil {
+			s.logger.Printf("Error checking token revocation: %v", err)
+			return nil, status.Error(codes.Internal, "failed to check token revocation")
+		}
+	}
+
 	return &pb.ValidateTokenResponse{
 		Valid:     true,
 		Message:   "token is valid",
 		UserId:    claims.UserID,
 		Username:  claims.Username,
 		ExpiresAt: claims.ExpiresAt.Unix(),
+		Role:      string(claims.Role),
 	}, nil
 }
+
+// randomHex returns n random bytes encoded as hex
+func randomHex(n int) (string, error) {
+	b := make([]byte, n)
+	if _, err := rand.Read(b); err != nil {
+		return "", err
+	}
+	return hex.EncodeToString(b), nil
+}
@@ -0,0 +1,72 @@
+package chat
+
+import (
+	"fmt"
+
+	"grpc-messenger-core/db/chat"
+
+	"github.com/spf13/viper"
+)
+
+// Broadcasters that carry room events to open streams
+const (
+	// BroadcasterMemory reaches the streams of this instance only
+	BroadcasterMemory = "memory"
+	// BroadcasterPostgres reaches the streams of every instance through
+	// PostgreSQL LISTEN/NOTIFY
+	BroadcasterPostgres = "postgres"
+)
+
+// Config holds the chat service settings from the [chat] config section
+type Config struct {
+	// How room events reach open streams: "memory" for a single instance,
+	// or "postgres" to run several instances behind a load balancer
+	Broadcaster string `mapstructure:"broadcaster"`
+
+	// Number of events queued for each stream before the slow consumer
+	// policy applies
+	QueueDepth int `mapstructure:"queue_depth"`
+
+	// What happens to a stream whose queue is full: "drop_oldest",
+	// "disconnect" or "replay". Replay only recovers new messages; the stream
+	// is then sent a resync event for the rest.
+	SlowConsumerPolicy chat.SlowConsumerPolicy `mapstructure:"slow_consumer_policy"`
+}
+
+// Defaults used when the settings are not configured
+const (
+	defaultQueueDepth         = 256
+	defaultSlowConsumerPolicy = chat.PolicyReplay
+)
+
+// LoadConfig loads the chat service settings from the config file
+func LoadConfig(path string) (Config, error) {
+	v := viper.New()
+	v.SetConfigFile(path)
+	v.SetDefault("chat.broadcaster", BroadcasterMemory)
+	v.SetDefault("chat.queue_depth", defaultQueueDepth)
+	v.SetDefault("chat.slow_consumer_policy", string(defaultSlowConsumerPolicy))
+
+	if err := v.ReadInConfig(); err != nil {
+		return Config{}, fmt.Errorf("failed to read config file: %w", err)
+	}
+
+	var config Config
+	if err := v.UnmarshalKey("chat", &config); err != nil {
+		return Config{}, fmt.Errorf("failed to unmarshal chat config: %w", err)
+	}
+
+	switch config.Broadcaster {
+	case BroadcasterMemory, BroadcasterPostgres:
+	default:
+		return Config{}, fmt.Errorf("unknown broadcaster %q", config.Broadcaster)
+	}
+	if config.QueueDepth < 1 {
+		return Config{}, fmt.Errorf("queue_depth must be at least 1")
+	}
+	if !config.SlowConsumerPolicy.Valid() {
+		return Config{}, fmt.Errorf("unknown slow consumer policy %q", config.SlowConsumerPolicy)
+	}
+
+	return config, nil
+}
@@ -0,0 +1,223 @@
+package chat
+
+import (
+	"context"
+	"time"
+
+	"grpc-messenger-core/db/chat"
+	"grpc-messenger-core/internal/middleware"
+	pb "grpc-messenger-core/proto/chat"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// StreamRoomEvents establishes a streaming connection for every kind of event
+// in a room, each wrapped in a RoomEvent envelope
+func (s *ChatService) StreamRoomEvents(req *pb.StreamRoomEventsRequest, stream pb.ChatService_StreamRoomEventsServer) error {
+	// Get context from the stream
+	ctx := stream.Context()
+
+	// Get the authenticated user
+	userID, err := middleware.CallerID(ctx, nil)
+	if err != nil {
+		return err
+	}
+
+	// For testing purposes, if db is nil, forward the events published by
+	// mock requests until the client disconnects
+	if s.db == nil {
+		s.logger.Println("Database connection is nil, continuing with mock event streaming")
+
+		// Mock streams cannot catch up from the database
+		sub := s.repo.SubscribeToRoom(req.RoomId, s.config.QueueDepth, chat.PolicyDropOldest)
+		defer s.repo.UnsubscribeFromRoom(sub)
+
+		for {
+			select {
+			case event := <-sub.Events():
+				if err := stream.Send(toPbRoomEvent(event)); err != nil {
+					s.logger.Printf("Error sending event to client: %v", err)
+					return status.Errorf(codes.Internal, "failed to send event to client")
+				}
+			case <-ctx.Done():
+				return nil
+			}
+		}
+	}
+
+	from, err := resumePoint(req.SinceMessageId, req.Cursor)
+	if err != nil {
+		return err
+	}
+
+	// Check the thread to follow starts in the room
+	if req.ThreadId != 0 {
+		if err := s.checkThread(ctx, req.RoomId, userID, req.ThreadId); err != nil {
+			return err
+		}
+	}
+
+	return s.streamRoom(ctx, req.RoomId, userID, from, func(event chat.Event) error {
+		// Only send message events of the thread followed, if any, or of
+		// the room's timeline
+		if event.Message != nil && !onStream(event.Message, req.ThreadId) {
+			return nil
+		}
+		return stream.Send(toPbRoomEvent(event))
+	}, func() {
+		// Tell the client why the stream ends
+		err := stream.Send(&pb.RoomEvent{
+			RoomId:    req.RoomId,
+			Timestamp: time.Now().Format(time.RFC3339),
+			Event:     &pb.RoomEvent_RoomDeleted{RoomDeleted: &pb.RoomDeletedEvent{}},
+		})
+		if err != nil {
+			s.logger.Printf("Error sending event to client: %v", err)
+		}
+	})
+}
+
+// SendTyping tells the room that the user started or stopped typing. Typing
+// indicators are not stored.
+func (s *ChatService) SendTyping(ctx context.Context, req *pb.SendTypingRequest) (*pb.SendTypingResponse, error) {
+	// Get the authenticated user
+	userID, err := middleware.CallerID(ctx, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	// For testing purposes, if db is nil, publish without checking membership
+	if s.db != nil {
+		// Check if the user is a member of the room
+		isMember, err := s.repo.IsRoomMember(ctx, req.RoomId, userID)
+		if err != nil {
+			s.logger.Printf("Error checking room membership: %v", err)
+			return nil, status.Errorf(codes.Internal, "failed to check room membership")
+		}
+		if !isMember {
+			return &pb.SendTypingResponse{
+				Success: false,
+				Message: "user is not a member of the room",
+			}, nil
+		}
+	}
+
+	s.repo.PublishRoomEvent(chat.Event{
+		Type:     chat.EventTyping,
+		RoomID:   req.RoomId,
+		Time:     time.Now(),
+		UserID:   userID,
+		Username: s.username(ctx, userID),
+		Active:   req.Typing,
+	})
+
+	return &pb.SendTypingResponse{
+		Success: true,
+		Message: "typing indicator sent",
+	}, nil
+}
+
+// publishPresence tells a room that a user came online or went offline
+func (s *ChatService) publishPresence(ctx context.Context, roomID, userID int64, online bool) {
+	s.repo.PublishRoomEvent(chat.Event{
+		Type:     chat.EventPresence,
+		RoomID:   roomID,
+		Time:     time.Now(),
+		UserID:   userID,
+		Username: s.username(ctx, userID),
+		Active:   online,
+	})
+}
+
+// deliverMemberEvent tells the streams of this instance that a user joined or
+// left a room. Every instance learns of membership changes from the database.
+func (s *ChatService) deliverMemberEvent(ctx context.Context, eventType chat.EventType, roomID, userID int64) {
+	username, err := s.repo.GetUsername(ctx, userID)
+	if err != nil {
+		s.logger.Printf("Error getting username of user %d: %v", userID, err)
+	}
+	s.repo.DeliverRoomEvent(chat.Event{
+		Type:     eventType,
+		RoomID:   roomID,
+		Time:     time.Now(),
+		UserID:   userID,
+		Username: username,
+	})
+}
+
+// username returns the username of the authenticated user
+func (s *ChatService) username(ctx context.Context, userID int64) string {
+	if claims, err := middleware.ClaimsFromContext(ctx); err == nil && claims.Username != "" {
+		return claims.Username
+	}
+	if s.db == nil {
+		return ""
+	}
+	username, err := s.repo.GetUsername(ctx, userID)
+	if err != nil {
+		s.logger.Printf("Error getting username of user %d: %v", userID, err)
+	}
+	return username
+}
+
+// toPbRoomEvent converts a room event to its protobuf envelope
+func toPbRoomEvent(event chat.Event) *pb.RoomEvent {
+	pbEvent := &pb.RoomEvent{
+		RoomId:    event.RoomID,
+		Timestamp: event.Time.Format(time.RFC3339),
+	}
+
+	switch event.Type {
+	case chat.EventMessage:
+		pbEvent.Event = &pb.RoomEvent_Message{Message: toPbMessage(event.Message)}
+	case chat.EventEdit:
+		pbEvent.Event = &pb.RoomEvent_MessageEdited{MessageEdited: toPbMessage(event.Message)}
+	case chat.EventDelete:
+		pbEvent.Event = &pb.RoomEvent_MessageDeleted{MessageDeleted: &pb.MessageDeleted{
+			MessageId: event.Message.ID,
+		}}
+	case chat.EventReaction:
+		pbEvent.Event = &pb.RoomEvent_Reaction{Reaction: &pb.ReactionEvent{
+			MessageId: event.Message.ID,
+			UserId:    event.UserID,
+			Emoji:     event.Emoji,
+			Added:     event.Active,
+			Username:  event.Username,
+		}}
+	case chat.EventTyping:
+		pbEvent.Event = &pb.RoomEvent_Typing{Typing: &pb.TypingEvent{
+			UserId:   event.UserID,
+			Username: event.Username,
+			Typing:   event.Active,
+		}}
+	case chat.EventPresence:
+		pbEvent.Event = &pb.RoomEvent_Presence{Presence: &pb.PresenceEvent{
+			UserId:   event.UserID,
+			Username: event.Username,
+			Online:   event.Active,
+		}}
+	case chat.EventMemberJoined:
+		pbEvent.Event = &pb.RoomEvent_MemberJoined{MemberJoined: &pb.MemberEvent{
+			UserId:   event.UserID,
+			Username: event.Username,
+		}}
+	case chat.EventMemberLeft:
+		pbEvent.Event = &pb.RoomEvent_MemberLeft{MemberLeft: &pb.MemberEvent{
+			UserId:   event.UserID,
+			Username: event.Username,
+		}}
+	case chat.EventRoomUpdated:
+		pbEvent.Event = &pb.RoomEvent_RoomUpdated{RoomUpdated: &pb.RoomUpdatedEvent{
+			Name:        event.Room.Name,
+			Description: event.Room.Description,
+			Topic:       event.Room.Topic,
+			AvatarUrl:   event.Room.AvatarURL,
+			Archived:    event.Room.Archived,
+		}}
+	case chat.EventResync:
+		pbEvent.Event = &pb.RoomEvent_Resync{Resync: &pb.ResyncEvent{}}
+	}
+
+	return pbEvent
+}
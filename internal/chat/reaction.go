@@ -0,0 +1,145 @@
+package chat
+
+import (
+	"context"
+	"errors"
+	"strings"
+	"unicode/utf8"
+
+	"grpc-messenger-core/db/chat"
+	"grpc-messenger-core/internal/middleware"
+	pb "grpc-messenger-core/proto/chat"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// maxEmojiLength is the maximum length of a reaction, in characters
+const maxEmojiLength = 32
+
+// AddReaction adds the user's emoji reaction to a message. Only room members
+// can react, and only once with each emoji.
+func (s *ChatService) AddReaction(ctx context.Context, req *pb.AddReactionRequest) (*pb.AddReactionResponse, error) {
+	// Get the authenticated user
+	userID, err := middleware.CallerID(ctx, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	// Validate request
+	if err := validateEmoji(req.Emoji); err != nil {
+		return nil, err
+	}
+
+	// For testing purposes, if db is nil, return success
+	if s.db == nil {
+		s.logger.Println("Database connection is nil, returning mock add reaction response")
+		return &pb.AddReactionResponse{
+			Success: true,
+			Message: "reaction added successfully",
+		}, nil
+	}
+
+	if _, err := s.changeableMessage(ctx, req.MessageId, userID); err != nil {
+		return nil, err
+	}
+
+	err = s.repo.AddReaction(ctx, req.MessageId, userID, req.Emoji)
+	if errors.Is(err, chat.ErrAlreadyReacted) {
+		return &pb.AddReactionResponse{
+			Success: false,
+			Message: "user already reacted with this emoji",
+		}, nil
+	}
+	if err != nil {
+		s.logger.Printf("Error adding reaction: %v", err)
+		return nil, status.Errorf(codes.Internal, "failed to add reaction")
+	}
+	s.publishReaction(ctx, req.MessageId, userID, req.Emoji, true)
+
+	return &pb.AddReactionResponse{
+		Success: true,
+		Message: "reaction added successfully",
+	}, nil
+}
+
+// RemoveReaction removes the user's emoji reaction from a message
+func (s *ChatService) RemoveReaction(ctx context.Context, req *pb.RemoveReactionRequest) (*pb.RemoveReactionResponse, error) {
+	// Get the authenticated user
+	userID, err := middleware.CallerID(ctx, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	// Validate request
+	if err := validateEmoji(req.Emoji); err != nil {
+		return nil, err
+	}
+
+	// For testing purposes, if db is nil, return success
+	if s.db == nil {
+		s.logger.Println("Database connection is nil, returning mock remove reaction response")
+		return &pb.RemoveReactionResponse{
+			Success: true,
+			Message: "reaction removed successfully",
+		}, nil
+	}
+
+	if _, err := s.changeableMessage(ctx, req.MessageId, userID); err != nil {
+		return nil, err
+	}
+
+	err = s.repo.RemoveReaction(ctx, req.MessageId, userID, req.Emoji)
+	if errors.Is(err, chat.ErrReactionNotFound) {
+		return &pb.RemoveReactionResponse{
+			Success: false,
+			Message: "user has not reacted with this emoji",
+		}, nil
+	}
+	if err != nil {
+		s.logger.Printf("Error removing reaction: %v", err)
+		return nil, status.Errorf(codes.Internal, "failed to remove reaction")
+	}
+	s.publishReaction(ctx, req.MessageId, userID, req.Emoji, false)
+
+	return &pb.RemoveReactionResponse{
+		Success: true,
+		Message: "reaction removed successfully",
+	}, nil
+}
+
+// publishReaction tells streams that a reaction was added or removed. The
+// change is already saved, so failures are only logged.
+func (s *ChatService) publishReaction(ctx context.Context, messageID, userID int64, emoji string, added bool) {
+	if err := s.repo.PublishReaction(ctx, messageID, userID, emoji, added); err != nil {
+		s.logger.Printf("Error publishing reaction to message %d: %v", messageID, err)
+	}
+}
+
+// validateEmoji checks that a reaction is a short string without surrounding
+// whitespace
+func validateEmoji(emoji string) error {
+	if emoji == "" {
+		return status.Errorf(codes.InvalidArgument, "emoji cannot be empty")
+	}
+	if strings.TrimSpace(emoji) != emoji || !utf8.ValidString(emoji) {
+		return status.Errorf(codes.InvalidArgument, "invalid emoji")
+	}
+	if utf8.RuneCountInString(emoji) > maxEmojiLength {
+		return status.Errorf(codes.InvalidArgument, "emoji cannot be longer than %d characters", maxEmojiLength)
+	}
+	return nil
+}
+
+// toPbReactions converts the reactions to a message to their protobuf form
+func toPbReactions(reactions []chat.Reaction) []*pb.ReactionCount {
+	pbReactions := make([]*pb.ReactionCount, 0, len(reactions))
+	for _, r := range reactions {
+		pbReactions = append(pbReactions, &pb.ReactionCount{
+			Emoji:       r.Emoji,
+			Count:       r.Count,
+			ReactedByMe: r.ReactedByMe,
+		})
+	}
+	return pbReactions
+}
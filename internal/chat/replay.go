@@ -0,0 +1,128 @@
+package chat
+
+import (
+	"context"
+	"database/sql"
+	"encoding/base64"
+	"encoding/json"
+	"errors"
+
+	"grpc-messenger-core/db/chat"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// Replay limits for resumed streams. Clients further behind than
+// maxReplayMessages should reload the history with GetRoomMessages.
+const (
+	replayPageSize    = 100
+	maxReplayMessages = 1000
+)
+
+// messageCursor is the position in a room's messages that a stream resumes
+// after
+type messageCursor struct {
+	Seq int64 `json:"s"`
+}
+
+// encodeMessageCursor returns the opaque cursor of a message, from its
+// position in its room
+func encodeMessageCursor(seq int64) string {
+	b, _ := json.Marshal(messageCursor{Seq: seq})
+	return base64.RawURLEncoding.EncodeToString(b)
+}
+
+// decodeMessageCursor parses a cursor returned by encodeMessageCursor
+func decodeMessageCursor(s string) (int64, error) {
+	b, err := base64.RawURLEncoding.DecodeString(s)
+	if err != nil {
+		return 0, err
+	}
+	var cursor messageCursor
+	if err := json.Unmarshal(b, &cursor); err != nil {
+		return 0, err
+	}
+	return cursor.Seq, nil
+}
+
+// resumeFrom is where a stream resumes: after a message, or after a position
+// in its room. Both are 0 to start with live messages only.
+type resumeFrom struct {
+	messageID int64
+	seq       int64
+}
+
+// resumePoint returns where a stream resumes, from either a message ID or a
+// cursor
+func resumePoint(sinceMessageID int64, cursor string) (resumeFrom, error) {
+	if sinceMessageID < 0 {
+		return resumeFrom{}, status.Errorf(codes.InvalidArgument, "invalid since_message_id")
+	}
+	if cursor == "" {
+		return resumeFrom{messageID: sinceMessageID}, nil
+	}
+	if sinceMessageID != 0 {
+		return resumeFrom{}, status.Errorf(codes.InvalidArgument, "set since_message_id or cursor, not both")
+	}
+	seq, err := decodeMessageCursor(cursor)
+	if err != nil || seq < 0 {
+		return resumeFrom{}, status.Errorf(codes.InvalidArgument, "invalid cursor")
+	}
+	return resumeFrom{seq: seq}, nil
+}
+
+// resumeSeq returns the position in a room that a stream resumes after, or 0
+// if it starts with live messages only. The caller must have checked that
+// the user is a member of the room.
+func (s *ChatService) resumeSeq(ctx context.Context, roomID int64, from resumeFrom) (int64, error) {
+	if from.messageID == 0 {
+		return from.seq, nil
+	}
+	seq, err := s.repo.GetMessageSeq(ctx, roomID, from.messageID)
+	if errors.Is(err, sql.ErrNoRows) {
+		return 0, status.Errorf(codes.InvalidArgument, "since_message_id is not a message of the room")
+	}
+	if err != nil {
+		s.logger.Printf("Error getting message position: %v", err)
+		return 0, status.Errorf(codes.Internal, "failed to get message")
+	}
+	return seq, nil
+}
+
+// replayMessages sends the stored messages of a room after position afterSeq
+// and returns the position of the last one, or afterSeq if there were none.
+// Positions follow commit order, so every message committed up to the last
+// one returned has been sent.
+func (s *ChatService) replayMessages(ctx context.Context, roomID, afterSeq int64, send func(chat.Event) error) (int64, error) {
+	for count := 0; ; {
+		messages, err := s.repo.GetMessagesAfter(ctx, roomID, afterSeq, replayPageSize)
+		if err != nil {
+			s.logger.Printf("Error getting messages to replay: %v", err)
+			return 0, status.Errorf(codes.Internal, "failed to replay messages")
+		}
+
+		for i := range messages {
+			if count >= maxReplayMessages {
+				return 0, status.Errorf(codes.OutOfRange, "too many messages to replay, reload them with GetRoomMessages")
+			}
+			msg := messages[i]
+			err := send(chat.Event{
+				Type:    chat.EventMessage,
+				RoomID:  roomID,
+				Time:    msg.Timestamp,
+				Message: &msg,
+			})
+			if err != nil {
+				s.logger.Printf("Error sending message to client: %v", err)
+				return 0, status.Errorf(codes.Internal, "failed to send message to client")
+			}
+			afterSeq = msg.Seq
+			count++
+		}
+
+		if len(messages) < replayPageSize {
+			return afterSeq, nil
+		}
+	}
+}
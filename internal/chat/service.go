@@ -3,16 +3,17 @@ package chat
 import (
 	"context"
 	"database/sql"
+	"errors"
 	"log"
 	"sync"
 	"time"
 
 	"grpc-messenger-core/db/chat"
+	"grpc-messenger-core/db/postgres"
 	"grpc-messenger-core/internal/middleware"
 	pb "grpc-messenger-core/proto/chat"
 
 	"google.golang.org/grpc/codes"
-	"google.golang.org/grpc/metadata"
 	"google.golang.org/grpc/status"
 )
 
@@ -22,6 +23,7 @@ type ChatService struct {
 	db     *sql.DB
 	logger *log.Logger
 	repo   *chat.Repository
+	config Config
 
 	// For testing purposes
 	mockMessagesMutex  sync.Mutex
@@ -31,7 +33,7 @@ type ChatService struct {
 }
 
 // NewChatService creates a new chat service
-func NewChatService(db *sql.DB, logger *log.Logger) *ChatService {
+func NewChatService(db *sql.DB, logger *log.Logger, config Config) *ChatService {
 	// Set the global logger
 	sharedLogger = logger
 
@@ -39,6 +41,7 @@ func NewChatService(db *sql.DB, logger *log.Logger) *ChatService {
 		db:            db,
 		logger:        logger,
 		repo:          chat.NewRepository(db),
+		config:        config,
 		mockMessages:  make(map[int64][]*pb.MessageResponse),
 		activeStreams: make(map[int64][]pb.ChatService_StreamRoomMessagesServer),
 	}
@@ -46,21 +49,22 @@ func NewChatService(db *sql.DB, logger *log.Logger) *ChatService {
 
 // SendMessage sends a message to a room
 func (s *ChatService) SendMessage(ctx context.Context, req *pb.SendMessageRequest) (*pb.SendMessageResponse, error) {
-	// Authenticate the user
-	userID, _, err := authenticateRequest(ctx)
+	// Get the authenticated user
+	userID, err := middleware.CallerID(ctx, req.SenderId)
 	if err != nil {
 		return nil, err
 	}
 
-	// Verify the user ID matches the authenticated user
-	if userID != req.SenderId {
-		return nil, status.Errorf(codes.PermissionDenied, "user ID does not match authenticated user")
-	}
-
 	// Validate request
 	if req.Content == "" {
 		return nil, status.Errorf(codes.InvalidArgument, "message content cannot be empty")
 	}
+	if req.ParentMessageId < 0 {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid parent message ID")
+	}
+	if req.ReplyToId < 0 {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid reply to ID")
+	}
 
 	// For testing purposes, if db is nil, return success and simulate a message
 	if s.db == nil {
@@ -69,8 +73,9 @@ func (s *ChatService) SendMessage(ctx context.Context, req *pb.SendMessageReques
 		// Create a mock message ID
 		mockMessageID := time.Now().Unix()
 
-		// Get username from context if available
-		_, username, _ := authenticateRequest(ctx)
+		// Get username from the authenticated user
+		claims, _ := middleware.ClaimsFromContext(ctx)
+		username := claims.Username
 		if username == "" {
 			username = "User"
 		}
@@ -81,7 +86,7 @@ func (s *ChatService) SendMessage(ctx context.Context, req *pb.SendMessageReques
 			mockMessage := chat.Message{
 				ID:         mockMessageID,
 				Content:    req.Content,
-				SenderID:   req.SenderId,
+				SenderID:   userID,
 				RoomID:     req.RoomId,
 				SenderName: username,
 				Timestamp:  time.Now(),
@@ -98,7 +103,7 @@ func (s *ChatService) SendMessage(ctx context.Context, req *pb.SendMessageReques
 				mockResponse := &pb.MessageResponse{
 					Id:         mockMessageID,
 					Content:    req.Content,
-					SenderId:   req.SenderId,
+					SenderId:   userID,
 					RoomId:     req.RoomId,
 					SenderName: username,
 					Timestamp:  time.Now().Format(time.RFC3339),
@@ -126,11 +131,10 @@ func (s *ChatService) SendMessage(ctx context.Context, req *pb.SendMessageReques
 		}, nil
 	}
 
-	// Check if the user is a member of the room
-	isMember, err := s.repo.IsRoomMember(ctx, req.RoomId, req.SenderId)
+	// Check if the user is a member who can post in the room
+	isMember, err := s.postingMember(ctx, req.RoomId, userID)
 	if err != nil {
-		s.logger.Printf("Error checking room membership: %v", err)
-		return nil, status.Errorf(codes.Internal, "failed to check room membership")
+		return nil, err
 	}
 	if !isMember {
 		return &pb.SendMessageResponse{
@@ -140,12 +144,32 @@ func (s *ChatService) SendMessage(ctx context.Context, req *pb.SendMessageReques
 	}
 
 	// Save message to database
-	messageID, err := s.repo.SaveMessage(ctx, req.Content, req.SenderId, req.RoomId)
+	messageID, err := s.repo.SaveMessage(ctx, chat.NewMessage{
+		Content:   req.Content,
+		SenderID:  userID,
+		RoomID:    req.RoomId,
+		ParentID:  req.ParentMessageId,
+		ReplyToID: req.ReplyToId,
+	})
+	if errors.Is(err, chat.ErrMessageNotFound) {
+		return nil, status.Errorf(codes.NotFound, "parent message does not exist")
+	}
+	if errors.Is(err, chat.ErrReplyToNotFound) {
+		return nil, status.Errorf(codes.NotFound, "message replied to does not exist")
+	}
 	if err != nil {
 		s.logger.Printf("Error saving message: %v", err)
 		return nil, status.Errorf(codes.Internal, "failed to save message")
 	}
 
+	// Show the thread's new reply count to streams. The reply is saved, so
+	// failing to do so does not fail the request.
+	if req.ParentMessageId != 0 {
+		if err := s.repo.PublishThreadChanged(ctx, messageID); err != nil {
+			s.logger.Printf("Error publishing thread update: %v", err)
+		}
+	}
+
 	return &pb.SendMessageResponse{
 		Success:   true,
 		Message:   "message sent successfully",
@@ -153,19 +177,54 @@ func (s *ChatService) SendMessage(ctx context.Context, req *pb.SendMessageReques
 	}, nil
 }
 
+// postingMember reports whether a user is a member of a room, and returns an
+// error if they are one but cannot post there because the room is archived
+// or they are muted
+func (s *ChatService) postingMember(ctx context.Context, roomID, userID int64) (isMember bool, err error) {
+	// Check if the user is a member of the room
+	isMember, err = s.repo.IsRoomMember(ctx, roomID, userID)
+	if err != nil {
+		s.logger.Printf("Error checking room membership: %v", err)
+		return false, status.Errorf(codes.Internal, "failed to check room membership")
+	}
+	if !isMember {
+		return false, nil
+	}
+
+	// Check if the room is archived
+	isArchived, err := s.repo.IsRoomArchived(ctx, roomID)
+	if errors.Is(err, sql.ErrNoRows) {
+		return false, status.Errorf(codes.NotFound, "room does not exist")
+	}
+	if err != nil {
+		s.logger.Printf("Error checking if room is archived: %v", err)
+		return false, status.Errorf(codes.Internal, "failed to check room")
+	}
+	if isArchived {
+		return false, status.Errorf(codes.FailedPrecondition, "room is archived")
+	}
+
+	// Check if the user is muted in the room
+	isMuted, err := s.repo.IsMuted(ctx, roomID, userID)
+	if err != nil {
+		s.logger.Printf("Error checking room mutes: %v", err)
+		return false, status.Errorf(codes.Internal, "failed to check room mutes")
+	}
+	if isMuted {
+		return false, status.Errorf(codes.PermissionDenied, "user is muted in the room")
+	}
+
+	return true, nil
+}
+
 // GetRoomMessages retrieves messages from a room
 func (s *ChatService) GetRoomMessages(ctx context.Context, req *pb.GetRoomMessagesRequest) (*pb.GetRoomMessagesResponse, error) {
-	// Authenticate the user
-	userID, _, err := authenticateRequest(ctx)
+	// Get the authenticated user
+	userID, err := middleware.CallerID(ctx, req.UserId)
 	if err != nil {
 		return nil, err
 	}
 
-	// Verify the user ID matches the authenticated user
-	if userID != req.UserId {
-		return nil, status.Errorf(codes.PermissionDenied, "user ID does not match authenticated user")
-	}
-
 	// For testing purposes, if db is nil, return mock messages
 	if s.db == nil {
 		s.logger.Println("Database connection is nil, returning mock messages")
@@ -198,7 +257,7 @@ func (s *ChatService) GetRoomMessages(ctx context.Context, req *pb.GetRoomMessag
 	}
 
 	// Check if the user is a member of the room
-	isMember, err := s.repo.IsRoomMember(ctx, req.RoomId, req.UserId)
+	isMember, err := s.repo.IsRoomMember(ctx, req.RoomId, userID)
 	if err != nil {
 		s.logger.Printf("Error checking room membership: %v", err)
 		return nil, status.Errorf(codes.Internal, "failed to check room membership")
@@ -214,17 +273,23 @@ func (s *ChatService) GetRoomMessages(ctx context.Context, req *pb.GetRoomMessag
 		return nil, status.Errorf(codes.Internal, "failed to get messages")
 	}
 
+	// Get the reactions to the messages
+	messageIDs := make([]int64, 0, len(messages))
+	for _, msg := range messages {
+		messageIDs = append(messageIDs, msg.ID)
+	}
+	reactions, err := s.repo.GetReactions(ctx, messageIDs, userID)
+	if err != nil {
+		s.logger.Printf("Error getting reactions: %v", err)
+		return nil, status.Errorf(codes.Internal, "failed to get reactions")
+	}
+
 	// Convert to protobuf messages
 	pbMessages := make([]*pb.MessageResponse, 0, len(messages))
-	for _, msg := range messages {
-		pbMessages = append(pbMessages, &pb.MessageResponse{
-			Id:         msg.ID,
-			Content:    msg.Content,
-			SenderId:   msg.SenderID,
-			RoomId:     msg.RoomID,
-			SenderName: msg.SenderName,
-			Timestamp:  msg.Timestamp.Format(time.RFC3339),
-		})
+	for i := range messages {
+		pbMessage := toPbMessage(&messages[i])
+		pbMessage.Reactions = toPbReactions(reactions[messages[i].ID])
+		pbMessages = append(pbMessages, pbMessage)
 	}
 
 	return &pb.GetRoomMessagesResponse{
@@ -237,17 +302,12 @@ func (s *ChatService) StreamRoomMessages(req *pb.StreamRoomMessagesRequest, stre
 	// Get context from the stream
 	ctx := stream.Context()
 
-	// Authenticate the user
-	userID, _, err := authenticateRequest(ctx)
+	// Get the authenticated user
+	userID, err := middleware.CallerID(ctx, req.UserId)
 	if err != nil {
 		return err
 	}
 
-	// Verify the user ID matches the authenticated user
-	if userID != req.UserId {
-		return status.Errorf(codes.PermissionDenied, "user ID does not match authenticated user")
-	}
-
 	// For testing purposes, if db is nil, just continue and set up mock streaming
 	if s.db == nil {
 		s.logger.Println("Database connection is nil, continuing with streaming")
@@ -292,46 +352,301 @@ func (s *ChatService) StreamRoomMessages(req *pb.StreamRoomMessagesRequest, stre
 		<-ctx.Done()
 		return nil
 	} else {
-		// Check if the user is a member of the room
-		isMember, err := s.repo.IsRoomMember(ctx, req.RoomId, req.UserId)
+		from, err := resumePoint(req.SinceMessageId, req.Cursor)
 		if err != nil {
-			s.logger.Printf("Error checking room membership: %v", err)
-			return status.Errorf(codes.Internal, "failed to check room membership")
+			return err
 		}
-		if !isMember {
-			return status.Errorf(codes.PermissionDenied, "user is not a member of the room")
+
+		// Check the thread to follow starts in the room
+		if req.ThreadId != 0 {
+			if err := s.checkThread(ctx, req.RoomId, userID, req.ThreadId); err != nil {
+				return err
+			}
 		}
 
-		// Create a message channel
-		messageChan := make(chan chat.Message)
-
-		// Subscribe to room messages
-		s.repo.SubscribeToRoom(req.RoomId, messageChan)
-		defer s.repo.UnsubscribeFromRoom(req.RoomId, messageChan)
-
-		// Stream messages to client
-		for {
-			select {
-			case msg := <-messageChan:
-				// Send message to client
-				err := stream.Send(&pb.MessageResponse{
-					Id:         msg.ID,
-					Content:    msg.Content,
-					SenderId:   msg.SenderID,
-					RoomId:     msg.RoomID,
-					SenderName: msg.SenderName,
-					Timestamp:  msg.Timestamp.Format(time.RFC3339),
-				})
-				if err != nil {
-					s.logger.Printf("Error sending message to client: %v", err)
-					return status.Errorf(codes.Internal, "failed to send message to client")
+		return s.streamRoom(ctx, req.RoomId, userID, from, func(event chat.Event) error {
+			switch event.Type {
+			case chat.EventMessage, chat.EventEdit, chat.EventDelete:
+				// Only send messages of the thread followed, if any, or of
+				// the room's timeline
+				if !onStream(event.Message, req.ThreadId) {
+					return nil
 				}
-			case <-ctx.Done():
-				// Client disconnected
+
+				// Send message, edit or delete to client
+				pbMessage := toPbMessage(event.Message)
+				pbMessage.Event = string(event.Type)
+				return stream.Send(pbMessage)
+			case chat.EventResync:
+				return stream.Send(&pb.MessageResponse{
+					RoomId:    req.RoomId,
+					Timestamp: event.Time.Format(time.RFC3339),
+					Event:     string(event.Type),
+				})
+			}
+			// Other events are only sent by StreamRoomEvents
+			return nil
+		}, func() {
+			// Tell the client why the stream ends
+			err := stream.Send(&pb.MessageResponse{
+				RoomId:    req.RoomId,
+				Timestamp: time.Now().Format(time.RFC3339),
+				Event:     string(chat.EventRoomDeleted),
+			})
+			if err != nil {
+				s.logger.Printf("Error sending message to client: %v", err)
+			}
+		})
+	}
+}
+
+// streamRoom passes the events of a room to send until the client disconnects
+// or the user can no longer see the room. If from is set, the messages after
+// it are replayed first. roomDeleted is called before the stream ends
+// because the room was deleted. Events the client is too slow to take are
+// handled according to the slow consumer policy.
+func (s *ChatService) streamRoom(ctx context.Context, roomID, userID int64, from resumeFrom, send func(chat.Event) error, roomDeleted func()) error {
+	ctx, cancel := context.WithCancel(ctx)
+	defer cancel()
+
+	// Watch for the user being removed from the room or the room being
+	// deleted. This starts before the membership check so a removal in
+	// between is not missed.
+	ended, stopWatching := s.repo.WatchMembership(roomID, userID)
+	defer stopWatching()
+
+	// Check if the user is a member of the room
+	isMember, err := s.repo.IsRoomMember(ctx, roomID, userID)
+	if err != nil {
+		s.logger.Printf("Error checking room membership: %v", err)
+		return status.Errorf(codes.Internal, "failed to check room membership")
+	}
+	if !isMember {
+		return status.Errorf(codes.PermissionDenied, "user is not a member of the room")
+	}
+
+	afterSeq, err := s.resumeSeq(ctx, roomID, from)
+	if err != nil {
+		return err
+	}
+
+	// Subscribe to room events. Live events are queued from here on, so
+	// nothing sent while stored messages are replayed is missed.
+	sub := s.repo.SubscribeToRoom(roomID, s.config.QueueDepth, s.config.SlowConsumerPolicy)
+	defer func() {
+		s.repo.UnsubscribeFromRoom(sub)
+		if dropped := sub.Dropped(); dropped > 0 {
+			s.logger.Printf("Stream of user %d in room %d dropped %d events", userID, roomID, dropped)
+		}
+	}()
+
+	// Replay the messages the client missed, or start after the last
+	// message committed. Either way lastSeq is the position of the last
+	// message the client has, which catching up starts from.
+	if afterSeq > 0 {
+		afterSeq, err = s.replayMessages(ctx, roomID, afterSeq, send)
+	} else {
+		afterSeq, err = s.repo.GetLatestMessageSeq(ctx, roomID)
+		if err != nil {
+			s.logger.Printf("Error getting latest message: %v", err)
+			err = status.Errorf(codes.Internal, "failed to get latest message")
+		}
+	}
+	if err != nil {
+		return err
+	}
+	lastSeq := afterSeq
+
+	// sendLive sends a live event, skipping messages at or before lastSeq,
+	// which the client already has. Live messages can arrive out of commit
+	// order, so one that skips positions is sent from the database along
+	// with those before it.
+	sendLive := func(event chat.Event) error {
+		if event.Type == chat.EventMessage && event.Message.Seq != 0 {
+			if event.Message.Seq <= lastSeq {
 				return nil
 			}
+			if event.Message.Seq > lastSeq+1 {
+				var err error
+				lastSeq, err = s.replayMessages(ctx, roomID, lastSeq, send)
+				return err
+			}
+			lastSeq = event.Message.Seq
 		}
+		if err := send(event); err != nil {
+			s.logger.Printf("Error sending message to client: %v", err)
+			return status.Errorf(codes.Internal, "failed to send message to client")
+		}
+		return nil
 	}
+
+	// Keep the user marked as online while the stream is open
+	s.touchPresence(ctx, userID)
+	heartbeat := time.NewTicker(chat.PresenceHeartbeat)
+	defer heartbeat.Stop()
+	if online, err := s.repo.AddViewer(ctx, roomID, userID); err != nil {
+		s.logger.Printf("Error recording viewer: %v", err)
+	} else if online {
+		s.publishPresence(ctx, roomID, userID, true)
+	}
+	defer func() {
+		// The client may be gone, so do not use its context
+		viewerCtx, cancel := context.WithTimeout(context.Background(), viewerTimeout)
+		defer cancel()
+		if offline, err := s.repo.RemoveViewer(viewerCtx, roomID, userID); err != nil {
+			s.logger.Printf("Error recording viewer: %v", err)
+		} else if offline {
+			s.publishPresence(ctx, roomID, userID, false)
+		}
+	}()
+
+	// Stream events to client
+	for {
+		select {
+		case event := <-sub.Events():
+			if err := sendLive(event); err != nil {
+				return err
+			}
+		case <-sub.Overflow():
+			if s.config.SlowConsumerPolicy == chat.PolicyDisconnect {
+				return status.Errorf(codes.ResourceExhausted, "stream is too slow to keep up with the room")
+			}
+
+			// Send what was queued before the overflow, then catch up on
+			// the messages dropped since from the database
+			for queued := true; queued; {
+				select {
+				case event := <-sub.Events():
+					if err := sendLive(event); err != nil {
+						return err
+					}
+				default:
+					queued = false
+				}
+			}
+			sub.Resume()
+			lastSeq, err = s.replayMessages(ctx, roomID, lastSeq, send)
+			if err != nil {
+				return err
+			}
+
+			// Edits, deletions and other events dropped meanwhile cannot be
+			// replayed, so tell the client to reload what it shows
+			err = sendLive(chat.Event{
+				Type:   chat.EventResync,
+				RoomID: roomID,
+				Time:   time.Now(),
+			})
+			if err != nil {
+				return err
+			}
+		case <-heartbeat.C:
+			s.touchPresence(ctx, userID)
+			if err := s.repo.TouchViewer(ctx, roomID, userID); err != nil {
+				s.logger.Printf("Error refreshing viewer: %v", err)
+			}
+		case reason := <-ended:
+			if reason == chat.RoomDeleted {
+				roomDeleted()
+				return status.Errorf(codes.NotFound, "room has been deleted")
+			}
+			// User was kicked, banned or left the room
+			return status.Errorf(codes.PermissionDenied, "user is no longer a member of the room")
+		case <-ctx.Done():
+			// Client disconnected
+			return nil
+		}
+	}
+}
+
+// toPbMessage converts a message to its protobuf form
+func toPbMessage(msg *chat.Message) *pb.MessageResponse {
+	pbMessage := &pb.MessageResponse{
+		Id:              msg.ID,
+		Content:         msg.Content,
+		SenderId:        msg.SenderID,
+		RoomId:          msg.RoomID,
+		SenderName:      msg.SenderName,
+		Timestamp:       msg.Timestamp.Format(time.RFC3339),
+		Deleted:         msg.Deleted,
+		Cursor:          encodeMessageCursor(msg.Seq),
+		ParentMessageId: msg.ParentID,
+		ReplyCount:      msg.ReplyCount,
+		ReplyToId:       msg.ReplyToID,
+
+		ForwardedFromRoomId:     msg.ForwardedFromRoomID,
+		ForwardedFromSenderId:   msg.ForwardedFromSenderID,
+		ForwardedFromSenderName: msg.ForwardedFromSenderName,
+	}
+	if msg.EditedAt.Valid {
+		pbMessage.EditedAt = msg.EditedAt.Time.Format(time.RFC3339)
+	}
+	if msg.LastReplyAt.Valid {
+		pbMessage.LastReplyAt = msg.LastReplyAt.Time.Format(time.RFC3339)
+	}
+	return pbMessage
+}
+
+// touchPresence marks a user as online
+func (s *ChatService) touchPresence(ctx context.Context, userID int64) {
+	if err := s.repo.TouchPresence(ctx, userID); err != nil {
+		s.logger.Printf("Error updating presence: %v", err)
+	}
+}
+
+// viewerTimeout bounds how long recording that a stream closed may take
+const viewerTimeout = 5 * time.Second
+
+// ListenRoomEvents ends the streams of users removed from a room, and of
+// rooms deleted, by any room service instance, and delivers system messages,
+// membership changes and room updates to open streams, until ctx is done
+func (s *ChatService) ListenRoomEvents(ctx context.Context, connStr string) error {
+	return postgres.ListenRoomEvents(ctx, connStr, s.logger, func(event postgres.RoomEvent) {
+		switch event.Type {
+		case postgres.RoomEventMemberAdded:
+			s.deliverMemberEvent(ctx, chat.EventMemberJoined, event.RoomID, event.UserID)
+		case postgres.RoomEventMemberRemoved:
+			s.repo.EndMembership(event.RoomID, event.UserID, chat.MemberRemoved)
+			s.deliverMemberEvent(ctx, chat.EventMemberLeft, event.RoomID, event.UserID)
+		case postgres.RoomEventRoomUpdated:
+			info, err := s.repo.GetRoomInfo(ctx, event.RoomID)
+			if err != nil {
+				s.logger.Printf("Error getting room %d: %v", event.RoomID, err)
+				return
+			}
+			s.repo.DeliverRoomEvent(chat.Event{
+				Type:   chat.EventRoomUpdated,
+				RoomID: event.RoomID,
+				Time:   time.Now(),
+				Room:   info,
+			})
+		case postgres.RoomEventRoomDeleted:
+			s.repo.EndRoom(event.RoomID, chat.RoomDeleted)
+		case postgres.RoomEventMessage:
+			msg, err := s.repo.GetMessage(ctx, event.MessageID)
+			if err != nil {
+				s.logger.Printf("Error getting message %d: %v", event.MessageID, err)
+				return
+			}
+			s.repo.DeliverRoomEvent(chat.Event{
+				Type:    chat.EventMessage,
+				RoomID:  event.RoomID,
+				Time:    msg.Timestamp,
+				Message: msg,
+			})
+		}
+	})
+}
+
+// UsePostgresBroadcaster carries room events between chat service instances
+// through the database, so that streams on every instance receive them
+func (s *ChatService) UsePostgresBroadcaster(ctx context.Context, connStr string) error {
+	broadcaster := chat.NewPostgresBroadcaster(s.repo, s.logger)
+	if err := broadcaster.Listen(ctx, connStr); err != nil {
+		return err
+	}
+	s.repo.SetBroadcaster(broadcaster)
+	return nil
 }
 
 // storeMockMessageAndBroadcast stores a mock message and broadcasts it to all active streams
@@ -379,31 +694,3 @@ func (s *ChatService) storeMockMessageAndBroadcast(message *pb.MessageResponse)
 	}
 	s.activeStreamsMutex.Unlock()
 }
-
-// Helper function to authenticate a request
-func authenticateRequest(ctx context.Context) (int64, string, error) {
-	md, ok := metadata.FromIncomingContext(ctx)
-	if !ok {
-		return 0, "", status.Errorf(codes.Unauthenticated, "metadata is not provided")
-	}
-
-	authHeader := md.Get("authorization")
-	if len(authHeader) == 0 {
-		return 0, "", status.Errorf(codes.Unauthenticated, "authorization token is not provided")
-	}
-
-	// Extract token from "Bearer <token>"
-	token := authHeader[0]
-	if len(token) <= 7 || token[:7] != "Bearer " {
-		return 0, "", status.Errorf(codes.Unauthenticated, "invalid authorization format")
-	}
-	token = token[7:]
-
-	// Validate token
-	claims, err := middleware.ValidateToken(token)
-	if err != nil {
-		return 0, "", status.Errorf(codes.Unauthenticated, "invalid token: %v", err)
-	}
-
-	return claims.UserID, claims.Username, nil
-}
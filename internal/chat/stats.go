@@ -0,0 +1,21 @@
+package chat
+
+import (
+	"context"
+
+	pb "grpc-messenger-core/proto/chat"
+)
+
+// GetStreamStats reports the open streams of this instance and the events
+// they dropped. Role checks are enforced by the auth interceptor.
+func (s *ChatService) GetStreamStats(ctx context.Context, req *pb.GetStreamStatsRequest) (*pb.GetStreamStatsResponse, error) {
+	stats := s.repo.StreamStats()
+
+	return &pb.GetStreamStatsResponse{
+		Success:       true,
+		Message:       "stream stats retrieved successfully",
+		OpenStreams:   stats.Subscriptions,
+		DroppedEvents: stats.DroppedEvents,
+		SlowConsumers: stats.Overflows,
+	}, nil
+}
@@ -1,7 +1,12 @@
 package middleware
 
 import (
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/hex"
 	"errors"
+	"fmt"
 	"time"
 
 	"github.com/golang-jwt/jwt/v5"
@@ -9,17 +14,21 @@ import (
 )
 
 const (
-	// Secret key for JWT signing
-	secretKey = "your-secret-key" // In production, this should be stored securely
-	
-	// Token expiration time
-	tokenExpiration = 24 * time.Hour
+	// Access token expiration time
+	accessTokenExpiration = 15 * time.Minute
+
+	// Refresh token expiration time
+	RefreshTokenExpiration = 30 * 24 * time.Hour
 )
 
 // Claims represents the JWT claims
 type Claims struct {
 	UserID   int64  `json:"user_id"`
 	Username string `json:"username"`
+	Role     Role   `json:"role"`
+	// TokenVersion is the user's token version at issue time. Tokens with an
+	// older version than the user's current one have been revoked.
+	TokenVersion int64 `json:"ver"`
 	jwt.RegisteredClaims
 }
 
@@ -35,44 +44,102 @@ func CheckPasswordHash(password, hash string) bool {
 	return err == nil
 }
 
-// GenerateToken creates a new JWT token for a user
-func GenerateToken(userID int64, username string) (string, error) {
-	expirationTime := time.Now().Add(tokenExpiration)
-	
+// GenerateToken creates a new short-lived JWT access token for a user and
+// returns it together with its expiration time
+func GenerateToken(userID int64, username string, role Role, tokenVersion int64) (string, time.Time, error) {
+	expirationTime := time.Now().Add(accessTokenExpiration)
+
+	tokenID, err := randomString(16)
+	if err != nil {
+		return "", time.Time{}, err
+	}
+
 	claims := &Claims{
-		UserID:   userID,
-		Username: username,
+		UserID:       userID,
+		Username:     username,
+		Role:         role,
+		TokenVersion: tokenVersion,
 		RegisteredClaims: jwt.RegisteredClaims{
+			ID:        tokenID,
 			ExpiresAt: jwt.NewNumericDate(expirationTime),
 			IssuedAt:  jwt.NewNumericDate(time.Now()),
 		},
 	}
-	
-	token := jwt.NewWithClaims(jwt.SigningMethodHS256, claims)
-	
-	tokenString, err := token.SignedString([]byte(secretKey))
+
+	ks := keySet.Load()
+	if ks == nil || ks.signing == nil {
+		return "", time.Time{}, errors.New("no JWT signing key configured")
+	}
+
+	token := jwt.NewWithClaims(ks.signing.Method, claims)
+	if ks.signing.ID != "" {
+		token.Header["kid"] = ks.signing.ID
+	}
+
+	tokenString, err := token.SignedString(ks.signing.SignKey)
 	if err != nil {
-		return "", err
+		return "", time.Time{}, err
+	}
+
+	return tokenString, expirationTime, nil
+}
+
+// GenerateRefreshToken creates a new opaque refresh token and returns it
+// together with the hash that should be stored in the database
+func GenerateRefreshToken() (string, string, error) {
+	token, err := randomString(32)
+	if err != nil {
+		return "", "", err
 	}
-	
-	return tokenString, nil
+	return token, HashRefreshToken(token), nil
+}
+
+// HashRefreshToken returns the hash under which a refresh token is stored
+func HashRefreshToken(token string) string {
+	sum := sha256.Sum256([]byte(token))
+	return hex.EncodeToString(sum[:])
 }
 
 // ValidateToken validates a JWT token and returns the claims
 func ValidateToken(tokenString string) (*Claims, error) {
 	claims := &Claims{}
-	
+
+	ks := keySet.Load()
+	if ks == nil {
+		return nil, errors.New("no JWT keys configured")
+	}
+
 	token, err := jwt.ParseWithClaims(tokenString, claims, func(token *jwt.Token) (interface{}, error) {
-		return []byte(secretKey), nil
+		kid, _ := token.Header["kid"].(string)
+		key, err := ks.Lookup(kid)
+		if err != nil {
+			return nil, err
+		}
+
+		// Only accept the algorithm the key was configured for
+		if token.Method.Alg() != key.Method.Alg() {
+			return nil, fmt.Errorf("unexpected signing method: %v", token.Header["alg"])
+		}
+
+		return key.VerifyKey, nil
 	})
-	
+
 	if err != nil {
 		return nil, err
 	}
-	
+
 	if !token.Valid {
 		return nil, errors.New("invalid token")
 	}
-	
+
 	return claims, nil
 }
+
+// randomString returns n random bytes encoded as a URL-safe string
+func randomString(n int) (string, error) {
+	b := make([]byte, n)
+	if _, err := rand.Read(b); err != nil {
+		return "", err
+	}
+	return base64.RawURLEncoding.EncodeToString(b), nil
+}
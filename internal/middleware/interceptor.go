@@ -0,0 +1,210 @@
+package middleware
+
+import (
+	"context"
+	"errors"
+	"log"
+	"strings"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+)
+
+// Methods of the gRPC reflection service, which never require a token
+var ReflectionMethods = []string{
+	"/grpc.reflection.v1.ServerReflection/",
+	"/grpc.reflection.v1alpha.ServerReflection/",
+}
+
+// claimsContextKey is the context key under which claims are stored
+type claimsContextKey struct{}
+
+// Authenticator validates the bearer token of incoming gRPC requests and
+// stores the caller's claims in the request context
+type Authenticator struct {
+	logger        *log.Logger
+	validate      func(token string) (*Claims, error)
+	revocations   *RevocationChecker
+	publicMethods map[string]bool
+	methodRoles   map[string]Role // Minimum role per method or service
+}
+
+// NewAuthenticator creates a new authenticator. Requests to publicMethods are
+// passed through without a token; a method ending in "/" allow-lists a whole
+// service. revocations may be nil to skip the revocation check.
+func NewAuthenticator(logger *log.Logger, revocations *RevocationChecker, publicMethods ...string) *Authenticator {
+	a := &Authenticator{
+		logger:        logger,
+		validate:      ValidateToken,
+		revocations:   revocations,
+		publicMethods: make(map[string]bool),
+		methodRoles:   make(map[string]Role),
+	}
+	for _, m := range publicMethods {
+		a.publicMethods[m] = true
+	}
+	return a
+}
+
+// SetValidator replaces the function used to validate tokens
+func (a *Authenticator) SetValidator(validate func(token string) (*Claims, error)) {
+	a.validate = validate
+}
+
+// RequireRole restricts methods to callers with at least the given role. As
+// with public methods, a method ending in "/" covers a whole service.
+func (a *Authenticator) RequireRole(role Role, methods ...string) {
+	for _, m := range methods {
+		a.methodRoles[m] = role
+	}
+}
+
+// UnaryInterceptor returns a unary server interceptor that authenticates requests
+func (a *Authenticator) UnaryInterceptor() grpc.UnaryServerInterceptor {
+	return func(ctx context.Context, req interface{}, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (interface{}, error) {
+		if a.isPublic(info.FullMethod) {
+			return handler(ctx, req)
+		}
+
+		claims, err := a.Authenticate(ctx)
+		if err != nil {
+			return nil, err
+		}
+		if err := a.authorize(info.FullMethod, claims); err != nil {
+			return nil, err
+		}
+
+		return handler(NewContextWithClaims(ctx, claims), req)
+	}
+}
+
+// StreamInterceptor returns a stream server interceptor that authenticates requests
+func (a *Authenticator) StreamInterceptor() grpc.StreamServerInterceptor {
+	return func(srv interface{}, ss grpc.ServerStream, info *grpc.StreamServerInfo, handler grpc.StreamHandler) error {
+		if a.isPublic(info.FullMethod) {
+			return handler(srv, ss)
+		}
+
+		claims, err := a.Authenticate(ss.Context())
+		if err != nil {
+			return err
+		}
+		if err := a.authorize(info.FullMethod, claims); err != nil {
+			return err
+		}
+
+		return handler(srv, &authenticatedStream{
+			ServerStream: ss,
+			ctx:          NewContextWithClaims(ss.Context(), claims),
+		})
+	}
+}
+
+// Authenticate validates the bearer token in the request metadata
+func (a *Authenticator) Authenticate(ctx context.Context) (*Claims, error) {
+	md, ok := metadata.FromIncomingContext(ctx)
+	if !ok {
+		return nil, status.Errorf(codes.Unauthenticated, "metadata is not provided")
+	}
+
+	authHeader := md.Get("authorization")
+	if len(authHeader) == 0 {
+		return nil, status.Errorf(codes.Unauthenticated, "authorization token is not provided")
+	}
+
+	// Extract token from "Bearer <token>"
+	token, ok := strings.CutPrefix(authHeader[0], "Bearer ")
+	if !ok || token == "" {
+		return nil, status.Errorf(codes.Unauthenticated, "invalid authorization format")
+	}
+
+	// Validate token
+	claims, err := a.validate(token)
+	if err != nil {
+		return nil, status.Errorf(codes.Unauthenticated, "invalid token: %v", err)
+	}
+
+	// Check if the token has been revoked
+	if a.revocations != nil {
+		err := a.revocations.Check(ctx, claims)
+		if errors.Is(err, ErrTokenRevoked) {
+			return nil, status.Errorf(codes.Unauthenticated, "token has been revoked")
+		}
+		if err != nil {
+			a.logger.Printf("Error checking token revocation: %v", err)
+			return nil, status.Errorf(codes.Internal, "failed to check token revocation")
+		}
+	}
+
+	return claims, nil
+}
+
+// isPublic reports whether a method can be called without a token
+func (a *Authenticator) isPublic(fullMethod string) bool {
+	if a.publicMethods[fullMethod] {
+		return true
+	}
+	return a.publicMethods[serviceOf(fullMethod)]
+}
+
+// authorize checks that the caller has the role required by a method
+func (a *Authenticator) authorize(fullMethod string, claims *Claims) error {
+	role, ok := a.methodRoles[fullMethod]
+	if !ok {
+		role, ok = a.methodRoles[serviceOf(fullMethod)]
+	}
+	if ok && !claims.Role.AtLeast(role) {
+		return status.Errorf(codes.PermissionDenied, "%s role required", role)
+	}
+	return nil
+}
+
+// serviceOf returns the "/package.Service/" prefix of a full method name
+func serviceOf(fullMethod string) string {
+	if i := strings.LastIndex(fullMethod, "/"); i >= 0 {
+		return fullMethod[:i+1]
+	}
+	return ""
+}
+
+// authenticatedStream is a server stream whose context carries the caller's claims
+type authenticatedStream struct {
+	grpc.ServerStream
+	ctx context.Context
+}
+
+// Context returns the stream context with the caller's claims
+func (s *authenticatedStream) Context() context.Context {
+	return s.ctx
+}
+
+// NewContextWithClaims returns a copy of ctx carrying the caller's claims
+func NewContextWithClaims(ctx context.Context, claims *Claims) context.Context {
+	return context.WithValue(ctx, claimsContextKey{}, claims)
+}
+
+// ClaimsFromContext returns the claims of the authenticated caller. Handlers
+// behind the authenticator can rely on them being present.
+func ClaimsFromContext(ctx context.Context) (*Claims, error) {
+	claims, ok := ctx.Value(claimsContextKey{}).(*Claims)
+	if !ok {
+		return nil, status.Errorf(codes.Unauthenticated, "request is not authenticated")
+	}
+	return claims, nil
+}
+
+// CallerID returns the authenticated caller's user ID. requestUserID is a
+// deprecated request field that used to identify the caller; while clients
+// migrate, a request that still sets it to another user is rejected.
+func CallerID(ctx context.Context, requestUserID *int64) (int64, error) {
+	claims, err := ClaimsFromContext(ctx)
+	if err != nil {
+		return 0, err
+	}
+	if requestUserID != nil && *requestUserID != claims.UserID {
+		return 0, status.Errorf(codes.PermissionDenied, "user ID does not match authenticated user")
+	}
+	return claims.UserID, nil
+}
@@ -0,0 +1,404 @@
+package middleware
+
+import (
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rsa"
+	"encoding/base64"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"math/big"
+	"net/http"
+	"os"
+	"strings"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	"github.com/golang-jwt/jwt/v5"
+	"github.com/spf13/viper"
+)
+
+// jwksRefreshInterval limits how often an unknown key ID triggers a JWKS fetch
+const jwksRefreshInterval = time.Minute
+
+// keySet is the key set used by GenerateToken and ValidateToken
+var keySet atomic.Pointer[KeySet]
+
+// SetKeySet sets the keys used to sign and validate tokens
+func SetKeySet(ks *KeySet) {
+	keySet.Store(ks)
+}
+
+// PublicKeys returns the public keys of the current key set as JSON Web Keys
+func PublicKeys() []JWK {
+	ks := keySet.Load()
+	if ks == nil {
+		return nil
+	}
+	return ks.JWKS()
+}
+
+// Key is a key used to sign or verify tokens
+type Key struct {
+	ID        string            // Sent as the kid header
+	Method    jwt.SigningMethod // HS256, RS256 or ES256
+	SignKey   interface{}       // Nil for verification-only keys
+	VerifyKey interface{}
+}
+
+// KeySet holds the key used to sign new tokens and all keys accepted when
+// validating tokens. Keeping old keys in the set lets tokens signed before a
+// rotation stay valid until they expire.
+type KeySet struct {
+	signing *Key
+
+	mu        sync.RWMutex
+	keys      map[string]*Key // kid -> key
+	jwksURL   string
+	lastFetch time.Time
+	client    *http.Client
+}
+
+// NewKeySet creates an empty key set
+func NewKeySet() *KeySet {
+	return &KeySet{
+		keys:   make(map[string]*Key),
+		client: &http.Client{Timeout: 10 * time.Second},
+	}
+}
+
+// SetSigningKey sets the key used to sign new tokens
+func (ks *KeySet) SetSigningKey(key *Key) {
+	ks.signing = key
+	ks.AddKey(key)
+}
+
+// AddKey adds a key accepted when validating tokens
+func (ks *KeySet) AddKey(key *Key) {
+	ks.mu.Lock()
+	defer ks.mu.Unlock()
+
+	ks.keys[key.ID] = key
+}
+
+// SetJWKSURL sets a URL from which unknown verification keys are fetched
+func (ks *KeySet) SetJWKSURL(url string) {
+	ks.mu.Lock()
+	defer ks.mu.Unlock()
+
+	ks.jwksURL = url
+}
+
+// Lookup returns the verification key with the given ID. Tokens without a kid
+// header are checked against the signing key if there is one.
+func (ks *KeySet) Lookup(kid string) (*Key, error) {
+	if kid == "" && ks.signing != nil {
+		return ks.signing, nil
+	}
+
+	ks.mu.RLock()
+	key, ok := ks.keys[kid]
+	ks.mu.RUnlock()
+	if ok {
+		return key, nil
+	}
+
+	// The issuer may have rotated to a key we haven't seen yet
+	if err := ks.refreshJWKS(); err != nil {
+		return nil, err
+	}
+
+	ks.mu.RLock()
+	key, ok = ks.keys[kid]
+	ks.mu.RUnlock()
+	if !ok {
+		return nil, fmt.Errorf("unknown key ID %q", kid)
+	}
+	return key, nil
+}
+
+// JWKS returns the public keys of the set as JSON Web Keys. Symmetric keys
+// are never published.
+func (ks *KeySet) JWKS() []JWK {
+	ks.mu.RLock()
+	defer ks.mu.RUnlock()
+
+	jwks := make([]JWK, 0, len(ks.keys))
+	for _, key := range ks.keys {
+		jwk, ok := newJWK(key)
+		if ok {
+			jwks = append(jwks, jwk)
+		}
+	}
+	return jwks
+}
+
+// refreshJWKS fetches keys from the JWKS URL, at most once per jwksRefreshInterval
+func (ks *KeySet) refreshJWKS() error {
+	ks.mu.Lock()
+	url := ks.jwksURL
+	if url == "" || time.Since(ks.lastFetch) < jwksRefreshInterval {
+		ks.mu.Unlock()
+		return nil
+	}
+	ks.lastFetch = time.Now()
+	ks.mu.Unlock()
+
+	resp, err := ks.client.Get(url)
+	if err != nil {
+		return fmt.Errorf("failed to fetch JWKS: %w", err)
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		return fmt.Errorf("failed to fetch JWKS: unexpected status %s", resp.Status)
+	}
+
+	var body struct {
+		Keys []JWK `json:"keys"`
+	}
+	if err := json.NewDecoder(resp.Body).Decode(&body); err != nil {
+		return fmt.Errorf("failed to decode JWKS: %w", err)
+	}
+
+	for _, jwk := range body.Keys {
+		key, err := jwk.Key()
+		if err != nil {
+			return fmt.Errorf("invalid key %q in JWKS: %w", jwk.Kid, err)
+		}
+		ks.AddKey(key)
+	}
+
+	return nil
+}
+
+// JWK is a JSON Web Key (RFC 7517) for an RSA or P-256 public key
+type JWK struct {
+	Kty string `json:"kty"`
+	Kid string `json:"kid"`
+	Use string `json:"use"`
+	Alg string `json:"alg"`
+	N   string `json:"n,omitempty"`
+	E   string `json:"e,omitempty"`
+	Crv string `json:"crv,omitempty"`
+	X   string `json:"x,omitempty"`
+	Y   string `json:"y,omitempty"`
+}
+
+// newJWK converts the public part of a key to a JWK
+func newJWK(key *Key) (JWK, bool) {
+	enc := base64.RawURLEncoding
+	jwk := JWK{Kid: key.ID, Use: "sig", Alg: key.Method.Alg()}
+
+	switch pub := key.VerifyKey.(type) {
+	case *rsa.PublicKey:
+		jwk.Kty = "RSA"
+		jwk.N = enc.EncodeToString(pub.N.Bytes())
+		jwk.E = enc.EncodeToString(big.NewInt(int64(pub.E)).Bytes())
+	case *ecdsa.PublicKey:
+		jwk.Kty = "EC"
+		jwk.Crv = pub.Curve.Params().Name
+		jwk.X = enc.EncodeToString(pub.X.FillBytes(make([]byte, 32)))
+		jwk.Y = enc.EncodeToString(pub.Y.FillBytes(make([]byte, 32)))
+	default:
+		return JWK{}, false
+	}
+
+	return jwk, true
+}
+
+// Key converts a JWK to a verification key
+func (jwk JWK) Key() (*Key, error) {
+	dec := base64.RawURLEncoding
+
+	switch jwk.Kty {
+	case "RSA":
+		n, err := dec.DecodeString(jwk.N)
+		if err != nil {
+			return nil, err
+		}
+		e, err := dec.DecodeString(jwk.E)
+		if err != nil {
+			return nil, err
+		}
+		pub := &rsa.PublicKey{N: new(big.Int).SetBytes(n), E: int(new(big.Int).SetBytes(e).Int64())}
+		return &Key{ID: jwk.Kid, Method: jwt.SigningMethodRS256, VerifyKey: pub}, nil
+	case "EC":
+		if jwk.Crv != "P-256" {
+			return nil, fmt.Errorf("unsupported curve %q", jwk.Crv)
+		}
+		x, err := dec.DecodeString(jwk.X)
+		if err != nil {
+			return nil, err
+		}
+		y, err := dec.DecodeString(jwk.Y)
+		if err != nil {
+			return nil, err
+		}
+		pub := &ecdsa.PublicKey{Curve: elliptic.P256(), X: new(big.Int).SetBytes(x), Y: new(big.Int).SetBytes(y)}
+		return &Key{ID: jwk.Kid, Method: jwt.SigningMethodES256, VerifyKey: pub}, nil
+	default:
+		return nil, fmt.Errorf("unsupported key type %q", jwk.Kty)
+	}
+}
+
+// KeyConfig is the [jwt] section of a service config file
+type KeyConfig struct {
+	Algorithm      string `mapstructure:"algorithm"` // HS256, RS256 or ES256
+	KeyID          string `mapstructure:"key_id"`
+	Secret         string `mapstructure:"secret"`
+	SecretFile     string `mapstructure:"secret_file"`
+	PrivateKeyFile string `mapstructure:"private_key_file"`
+	PublicKeyFile  string `mapstructure:"public_key_file"`
+
+	// Additional keys accepted when validating tokens, e.g. during a rotation
+	VerificationKeys []KeyConfig `mapstructure:"verification_keys"`
+
+	// URL of the auth service's JWKS endpoint
+	JWKSURL string `mapstructure:"jwks_url"`
+}
+
+// LoadKeySet loads the [jwt] section of a config file. The JWT_SECRET
+// environment variable overrides the configured secret. The primary key is
+// used for signing when it includes a secret or private key.
+func LoadKeySet(path string) (*KeySet, error) {
+	v := viper.New()
+	v.SetConfigFile(path)
+	v.BindEnv("jwt.secret", "JWT_SECRET")
+
+	if err := v.ReadInConfig(); err != nil {
+		return nil, fmt.Errorf("failed to read config file: %w", err)
+	}
+
+	var config KeyConfig
+	if err := v.UnmarshalKey("jwt", &config); err != nil {
+		return nil, fmt.Errorf("failed to unmarshal jwt config: %w", err)
+	}
+	if secret := v.GetString("jwt.secret"); secret != "" {
+		config.Secret = secret
+	}
+
+	return NewKeySetFromConfig(config)
+}
+
+// NewKeySetFromConfig creates a key set from a KeyConfig
+func NewKeySetFromConfig(config KeyConfig) (*KeySet, error) {
+	ks := NewKeySet()
+	ks.SetJWKSURL(config.JWKSURL)
+
+	if config.Algorithm != "" || config.Secret != "" || config.SecretFile != "" ||
+		config.PrivateKeyFile != "" || config.PublicKeyFile != "" {
+		primary, err := loadKey(config)
+		if err != nil {
+			return nil, err
+		}
+		if primary.SignKey != nil {
+			ks.SetSigningKey(primary)
+		} else {
+			ks.AddKey(primary)
+		}
+	}
+
+	for _, c := range config.VerificationKeys {
+		key, err := loadKey(c)
+		if err != nil {
+			return nil, err
+		}
+		key.SignKey = nil
+		ks.AddKey(key)
+	}
+
+	if len(ks.keys) == 0 && config.JWKSURL == "" {
+		return nil, errors.New("no JWT keys configured")
+	}
+
+	return ks, nil
+}
+
+// loadKey loads a single key from its config
+func loadKey(config KeyConfig) (*Key, error) {
+	alg := config.Algorithm
+	if alg == "" {
+		alg = jwt.SigningMethodHS256.Alg()
+	}
+	key := &Key{ID: config.KeyID}
+
+	switch alg {
+	case "HS256":
+		secret := config.Secret
+		if config.SecretFile != "" {
+			data, err := os.ReadFile(config.SecretFile)
+			if err != nil {
+				return nil, fmt.Errorf("failed to read secret file: %w", err)
+			}
+			secret = strings.TrimSpace(string(data))
+		}
+		if secret == "" {
+			return nil, errors.New("HS256 key requires a secret")
+		}
+		key.Method = jwt.SigningMethodHS256
+		key.SignKey = []byte(secret)
+		key.VerifyKey = []byte(secret)
+	case "RS256":
+		key.Method = jwt.SigningMethodRS256
+		if config.PrivateKeyFile != "" {
+			data, err := os.ReadFile(config.PrivateKeyFile)
+			if err != nil {
+				return nil, fmt.Errorf("failed to read private key file: %w", err)
+			}
+			priv, err := jwt.ParseRSAPrivateKeyFromPEM(data)
+			if err != nil {
+				return nil, fmt.Errorf("failed to parse RSA private key: %w", err)
+			}
+			key.SignKey = priv
+			key.VerifyKey = &priv.PublicKey
+		} else if config.PublicKeyFile != "" {
+			data, err := os.ReadFile(config.PublicKeyFile)
+			if err != nil {
+				return nil, fmt.Errorf("failed to read public key file: %w", err)
+			}
+			pub, err := jwt.ParseRSAPublicKeyFromPEM(data)
+			if err != nil {
+				return nil, fmt.Errorf("failed to parse RSA public key: %w", err)
+			}
+			key.VerifyKey = pub
+		} else {
+			return nil, errors.New("RS256 key requires a private or public key file")
+		}
+	case "ES256":
+		key.Method = jwt.SigningMethodES256
+		if config.PrivateKeyFile != "" {
+			data, err := os.ReadFile(config.PrivateKeyFile)
+			if err != nil {
+				return nil, fmt.Errorf("failed to read private key file: %w", err)
+			}
+			priv, err := jwt.ParseECPrivateKeyFromPEM(data)
+			if err != nil {
+				return nil, fmt.Errorf("failed to parse EC private key: %w", err)
+			}
+			key.SignKey = priv
+			key.VerifyKey = &priv.PublicKey
+		} else if config.PublicKeyFile != "" {
+			data, err := os.ReadFile(config.PublicKeyFile)
+			if err != nil {
+				return nil, fmt.Errorf("failed to read public key file: %w", err)
+			}
+			pub, err := jwt.ParseECPublicKeyFromPEM(data)
+			if err != nil {
+				return nil, fmt.Errorf("failed to parse EC public key: %w", err)
+			}
+			key.VerifyKey = pub
+		} else {
+			return nil, errors.New("ES256 key requires a private or public key file")
+		}
+		if key.VerifyKey.(*ecdsa.PublicKey).Curve != elliptic.P256() {
+			return nil, errors.New("ES256 key must use the P-256 curve")
+		}
+	default:
+		return nil, fmt.Errorf("unsupported JWT algorithm %q", alg)
+	}
+
+	return key, nil
+}
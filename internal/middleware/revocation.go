@@ -0,0 +1,171 @@
+package middleware
+
+import (
+	"context"
+	"errors"
+	"sync"
+	"time"
+)
+
+// ErrTokenRevoked is returned for tokens that have been revoked
+var ErrTokenRevoked = errors.New("token has been revoked")
+
+// DefaultRevocationCacheTTL is how long revocation lookups are cached
+const DefaultRevocationCacheTTL = 30 * time.Second
+
+// maxRevocationCacheEntries bounds the cache before expired entries are swept
+const maxRevocationCacheEntries = 10000
+
+// RevocationStore looks up token revocation state
+type RevocationStore interface {
+	// IsTokenRevoked reports whether the token with the given ID was revoked
+	IsTokenRevoked(ctx context.Context, tokenID string) (bool, error)
+	// GetTokenVersion returns the user's current token version
+	GetTokenVersion(ctx context.Context, userID int64) (int64, error)
+}
+
+// revocationEntry is a cached revocation lookup
+type revocationEntry struct {
+	revoked   bool
+	expiresAt time.Time
+}
+
+// versionEntry is a cached token version lookup
+type versionEntry struct {
+	version   int64
+	expiresAt time.Time
+}
+
+// RevocationChecker checks tokens against a RevocationStore, caching results
+// so that most requests don't need a database round trip. A revocation made
+// on another instance is picked up once the cached entry expires.
+type RevocationChecker struct {
+	store RevocationStore
+	ttl   time.Duration
+
+	mu       sync.Mutex
+	tokens   map[string]revocationEntry // token ID -> revocation state
+	versions map[int64]versionEntry     // user ID -> token version
+}
+
+// NewRevocationChecker creates a new revocation checker
+func NewRevocationChecker(store RevocationStore, ttl time.Duration) *RevocationChecker {
+	return &RevocationChecker{
+		store:    store,
+		ttl:      ttl,
+		tokens:   make(map[string]revocationEntry),
+		versions: make(map[int64]versionEntry),
+	}
+}
+
+// Check returns ErrTokenRevoked if the token described by claims was revoked
+// individually or by a log out of all the user's sessions
+func (c *RevocationChecker) Check(ctx context.Context, claims *Claims) error {
+	revoked, err := c.isTokenRevoked(ctx, claims)
+	if err != nil {
+		return err
+	}
+	if revoked {
+		return ErrTokenRevoked
+	}
+
+	version, err := c.tokenVersion(ctx, claims.UserID)
+	if err != nil {
+		return err
+	}
+	if claims.TokenVersion < version {
+		return ErrTokenRevoked
+	}
+
+	return nil
+}
+
+// Revoke records a token revocation in the local cache
+func (c *RevocationChecker) Revoke(tokenID string, expiresAt time.Time) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	c.tokens[tokenID] = revocationEntry{revoked: true, expiresAt: expiresAt}
+}
+
+// SetTokenVersion records a user's new token version in the local cache
+func (c *RevocationChecker) SetTokenVersion(userID, version int64) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	c.versions[userID] = versionEntry{version: version, expiresAt: time.Now().Add(c.ttl)}
+}
+
+// isTokenRevoked looks up a token's revocation state, using the cache if possible
+func (c *RevocationChecker) isTokenRevoked(ctx context.Context, claims *Claims) (bool, error) {
+	if claims.ID == "" {
+		return false, nil
+	}
+
+	now := time.Now()
+	c.mu.Lock()
+	entry, ok := c.tokens[claims.ID]
+	c.mu.Unlock()
+	if ok && now.Before(entry.expiresAt) {
+		return entry.revoked, nil
+	}
+
+	revoked, err := c.store.IsTokenRevoked(ctx, claims.ID)
+	if err != nil {
+		return false, err
+	}
+
+	// A revocation is permanent, so keep it for the rest of the token's life
+	expiresAt := now.Add(c.ttl)
+	if revoked && claims.ExpiresAt != nil {
+		expiresAt = claims.ExpiresAt.Time
+	}
+
+	c.mu.Lock()
+	c.sweepLocked(now)
+	c.tokens[claims.ID] = revocationEntry{revoked: revoked, expiresAt: expiresAt}
+	c.mu.Unlock()
+
+	return revoked, nil
+}
+
+// tokenVersion looks up a user's token version, using the cache if possible
+func (c *RevocationChecker) tokenVersion(ctx context.Context, userID int64) (int64, error) {
+	now := time.Now()
+	c.mu.Lock()
+	entry, ok := c.versions[userID]
+	c.mu.Unlock()
+	if ok && now.Before(entry.expiresAt) {
+		return entry.version, nil
+	}
+
+	version, err := c.store.GetTokenVersion(ctx, userID)
+	if err != nil {
+		return 0, err
+	}
+
+	c.mu.Lock()
+	c.sweepLocked(now)
+	c.versions[userID] = versionEntry{version: version, expiresAt: now.Add(c.ttl)}
+	c.mu.Unlock()
+
+	return version, nil
+}
+
+// sweepLocked drops expired entries once the cache grows too large.
+// c.mu must be held.
+func (c *RevocationChecker) sweepLocked(now time.Time) {
+	if len(c.tokens)+len(c.versions) < maxRevocationCacheEntries {
+		return
+	}
+	for id, entry := range c.tokens {
+		if !now.Before(entry.expiresAt) {
+			delete(c.tokens, id)
+		}
+	}
+	for id, entry := range c.versions {
+		if !now.Before(entry.expiresAt) {
+			delete(c.versions, id)
+		}
+	}
+}
@@ -0,0 +1,101 @@
+package middleware
+
+// Role is a site-wide user role
+type Role string
+
+// Site roles, from least to most privileged
+const (
+	RoleUser      Role = "user"
+	RoleModerator Role = "moderator"
+	RoleAdmin     Role = "admin"
+)
+
+// roleRanks orders roles by privilege
+var roleRanks = map[Role]int{
+	RoleUser:      1,
+	RoleModerator: 2,
+	RoleAdmin:     3,
+}
+
+// Valid reports whether r is a known role
+func (r Role) Valid() bool {
+	_, ok := roleRanks[r]
+	return ok
+}
+
+// AtLeast reports whether r grants at least the privileges of other
+func (r Role) AtLeast(other Role) bool {
+	return roleRanks[r] >= roleRanks[other]
+}
+
+// RoomRole is a user's role within a room
+type RoomRole string
+
+// Room roles, from least to most privileged. Each room has exactly one owner.
+const (
+	RoomRoleMember    RoomRole = "member"
+	RoomRoleModerator RoomRole = "moderator"
+	RoomRoleAdmin     RoomRole = "admin"
+	RoomRoleOwner     RoomRole = "owner"
+)
+
+// roomRoleRanks orders room roles by privilege
+var roomRoleRanks = map[RoomRole]int{
+	RoomRoleMember:    1,
+	RoomRoleModerator: 2,
+	RoomRoleAdmin:     3,
+	RoomRoleOwner:     4,
+}
+
+// Valid reports whether r is a known room role
+func (r RoomRole) Valid() bool {
+	_, ok := roomRoleRanks[r]
+	return ok
+}
+
+// AtLeast reports whether r grants at least the privileges of other
+func (r RoomRole) AtLeast(other RoomRole) bool {
+	return roomRoleRanks[r] >= roomRoleRanks[other]
+}
+
+// Outranks reports whether r is strictly more privileged than other
+func (r RoomRole) Outranks(other RoomRole) bool {
+	return roomRoleRanks[r] > roomRoleRanks[other]
+}
+
+// RoomAction is a privileged operation within a room
+type RoomAction string
+
+// Privileged room actions
+const (
+	RoomActionInvite        RoomAction = "invite"
+	RoomActionKick          RoomAction = "kick"
+	RoomActionBan           RoomAction = "ban"
+	RoomActionMute          RoomAction = "mute"
+	RoomActionDeleteMessage RoomAction = "delete_message"
+	RoomActionApproveJoin   RoomAction = "approve_join"
+	RoomActionManageRoles   RoomAction = "manage_roles"
+	RoomActionManageInvites RoomAction = "manage_invites"
+	RoomActionUpdateRoom    RoomAction = "update_room"
+	RoomActionDeleteRoom    RoomAction = "delete_room"
+)
+
+// roomActionRoles is the minimum room role required for each action
+var roomActionRoles = map[RoomAction]RoomRole{
+	RoomActionInvite:        RoomRoleModerator,
+	RoomActionKick:          RoomRoleModerator,
+	RoomActionBan:           RoomRoleModerator,
+	RoomActionMute:          RoomRoleModerator,
+	RoomActionDeleteMessage: RoomRoleModerator,
+	RoomActionApproveJoin:   RoomRoleModerator,
+	RoomActionManageRoles:   RoomRoleAdmin,
+	RoomActionManageInvites: RoomRoleAdmin,
+	RoomActionUpdateRoom:    RoomRoleAdmin,
+	RoomActionDeleteRoom:    RoomRoleOwner,
+}
+
+// Can reports whether a member with role r may perform action
+func (r RoomRole) Can(action RoomAction) bool {
+	required, ok := roomActionRoles[action]
+	return ok && r.AtLeast(required)
+}
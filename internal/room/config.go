@@ -0,0 +1,39 @@
+package room
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/spf13/viper"
+)
+
+// Config holds the room service settings from the [rooms] config section
+type Config struct {
+	// How long a deleted room's messages and members are kept before they
+	// are purged. Zero deletes them immediately.
+	DeletedRoomRetention time.Duration `mapstructure:"deleted_room_retention"`
+
+	// Maximum number of participants in a group conversation
+	MaxGroupMembers int64 `mapstructure:"max_group_members"`
+}
+
+// defaultMaxGroupMembers is used when max_group_members is not configured
+const defaultMaxGroupMembers = 50
+
+// LoadConfig loads the room service settings from the config file
+func LoadConfig(path string) (Config, error) {
+	v := viper.New()
+	v.SetConfigFile(path)
+	v.SetDefault("rooms.max_group_members", defaultMaxGroupMembers)
+
+	if err := v.ReadInConfig(); err != nil {
+		return Config{}, fmt.Errorf("failed to read config file: %w", err)
+	}
+
+	var config Config
+	if err := v.UnmarshalKey("rooms", &config); err != nil {
+		return Config{}, fmt.Errorf("failed to unmarshal rooms config: %w", err)
+	}
+
+	return config, nil
+}
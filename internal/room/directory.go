@@ -0,0 +1,108 @@
+package room
+
+import (
+	"context"
+	"encoding/base64"
+	"encoding/json"
+	"time"
+
+	"grpc-messenger-core/db/room"
+	pb "grpc-messenger-core/proto/room"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// Default and maximum page size for SearchRooms
+const (
+	defaultSearchRoomsPageSize = 20
+	maxSearchRoomsPageSize     = 100
+)
+
+// SearchRooms lists public rooms whose name or description matches the query,
+// sorted by member count or recent activity, one page at a time
+func (s *RoomService) SearchRooms(ctx context.Context, req *pb.SearchRoomsRequest) (*pb.SearchRoomsResponse, error) {
+	// Validate request
+	sort := room.SortByMemberCount
+	if req.SortBy != "" {
+		sort = room.DirectorySort(req.SortBy)
+	}
+	if sort != room.SortByMemberCount && sort != room.SortByRecentActivity {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid sort %q", req.SortBy)
+	}
+
+	pageSize := req.PageSize
+	if pageSize <= 0 {
+		pageSize = defaultSearchRoomsPageSize
+	}
+	if pageSize > maxSearchRoomsPageSize {
+		pageSize = maxSearchRoomsPageSize
+	}
+
+	var after *room.DirectoryCursor
+	if req.Cursor != "" {
+		cursor, err := decodeDirectoryCursor(req.Cursor)
+		if err != nil {
+			return nil, status.Errorf(codes.InvalidArgument, "invalid cursor")
+		}
+		after = cursor
+	}
+
+	// For testing purposes, if db is nil, return no rooms
+	if s.db == nil {
+		s.logger.Println("Database connection is nil, returning mock room directory")
+		return &pb.SearchRoomsResponse{}, nil
+	}
+
+	// Fetch one extra room to know whether there is a next page
+	rooms, err := s.repo.SearchPublicRooms(ctx, req.Query, sort, after, pageSize+1)
+	if err != nil {
+		s.logger.Printf("Error searching rooms: %v", err)
+		return nil, status.Errorf(codes.Internal, "failed to search rooms")
+	}
+
+	resp := &pb.SearchRoomsResponse{}
+	if int64(len(rooms)) > pageSize {
+		rooms = rooms[:pageSize]
+		last := rooms[len(rooms)-1]
+		cursor, err := encodeDirectoryCursor(last.Cursor(sort))
+		if err != nil {
+			s.logger.Printf("Error encoding cursor: %v", err)
+			return nil, status.Errorf(codes.Internal, "failed to search rooms")
+		}
+		resp.NextCursor = cursor
+	}
+
+	// Convert to protobuf rooms
+	resp.Rooms = make([]*pb.RoomResponse, 0, len(rooms))
+	for i := range rooms {
+		pbRoom := toPbRoom(&rooms[i].Room)
+		pbRoom.MemberCount = rooms[i].MemberCount
+		pbRoom.LastActivityAt = rooms[i].LastActivity.Format(time.RFC3339)
+		resp.Rooms = append(resp.Rooms, pbRoom)
+	}
+
+	return resp, nil
+}
+
+// encodeDirectoryCursor returns the opaque form of a directory cursor
+func encodeDirectoryCursor(cursor room.DirectoryCursor) (string, error) {
+	b, err := json.Marshal(cursor)
+	if err != nil {
+		return "", err
+	}
+	return base64.RawURLEncoding.EncodeToString(b), nil
+}
+
+// decodeDirectoryCursor parses a cursor returned by encodeDirectoryCursor
+func decodeDirectoryCursor(s string) (*room.DirectoryCursor, error) {
+	b, err := base64.RawURLEncoding.DecodeString(s)
+	if err != nil {
+		return nil, err
+	}
+	var cursor room.DirectoryCursor
+	if err := json.Unmarshal(b, &cursor); err != nil {
+		return nil, err
+	}
+	return &cursor, nil
+}
@@ -0,0 +1,254 @@
+package room
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+
+	"grpc-messenger-core/db/room"
+	"grpc-messenger-core/internal/middleware"
+	pb "grpc-messenger-core/proto/room"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// minGroupMembers is the smallest group conversation; two people use a
+// direct conversation instead
+const minGroupMembers = 3
+
+// displayNameParticipants is how many participants a group display name lists
+// before summarising the rest
+const displayNameParticipants = 3
+
+// CreateGroupConversation starts a group conversation between the user and at
+// least two other users
+func (s *RoomService) CreateGroupConversation(ctx context.Context, req *pb.CreateGroupConversationRequest) (*pb.CreateGroupConversationResponse, error) {
+	// Get the authenticated user
+	userID, err := middleware.CallerID(ctx, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	// Validate request
+	userIDs := otherUserIDs(req.UserIds, userID)
+	if len(userIDs)+1 < minGroupMembers {
+		return nil, status.Errorf(codes.InvalidArgument, "a group conversation needs at least %d participants", minGroupMembers)
+	}
+	if s.config.MaxGroupMembers > 0 && int64(len(userIDs)+1) > s.config.MaxGroupMembers {
+		return nil, status.Errorf(codes.InvalidArgument, "a group conversation can have at most %d participants", s.config.MaxGroupMembers)
+	}
+
+	// For testing purposes, if db is nil, return a mock conversation
+	if s.db == nil {
+		s.logger.Println("Database connection is nil, returning mock group conversation")
+		participants := []*pb.GroupParticipant{{UserId: userID}}
+		for _, id := range userIDs {
+			participants = append(participants, &pb.GroupParticipant{UserId: id})
+		}
+		return &pb.CreateGroupConversationResponse{
+			Conversation: &pb.GroupConversation{
+				RoomId:       1,
+				Participants: participants,
+				CreatedAt:    time.Now().Format(time.RFC3339),
+			},
+		}, nil
+	}
+
+	if err := s.requireUsersExist(ctx, userIDs); err != nil {
+		return nil, err
+	}
+
+	roomID, err := s.repo.CreateGroupConversation(ctx, userID, userIDs, s.config.MaxGroupMembers)
+	if err != nil {
+		s.logger.Printf("Error creating group conversation: %v", err)
+		return nil, status.Errorf(codes.Internal, "failed to create group conversation")
+	}
+
+	g, err := s.repo.GetGroupConversation(ctx, roomID)
+	if err != nil {
+		s.logger.Printf("Error getting group conversation: %v", err)
+		return nil, status.Errorf(codes.Internal, "failed to get group conversation")
+	}
+
+	return &pb.CreateGroupConversationResponse{
+		Conversation: toPbGroupConversation(g, userID),
+	}, nil
+}
+
+// AddGroupConversationMembers adds users to a group conversation. Any
+// participant can add people; the conversation keeps its room and history.
+func (s *RoomService) AddGroupConversationMembers(ctx context.Context, req *pb.AddGroupConversationMembersRequest) (*pb.AddGroupConversationMembersResponse, error) {
+	// Get the authenticated user
+	userID, err := middleware.CallerID(ctx, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	// Validate request
+	userIDs := otherUserIDs(req.UserIds, userID)
+	if len(userIDs) == 0 {
+		return nil, status.Errorf(codes.InvalidArgument, "no users to add")
+	}
+
+	// For testing purposes, if db is nil, return success
+	if s.db == nil {
+		s.logger.Println("Database connection is nil, returning mock add group members response")
+		return &pb.AddGroupConversationMembersResponse{
+			Success: true,
+			Message: "members added successfully",
+		}, nil
+	}
+
+	// Check that this is a group conversation the user takes part in
+	r, err := s.repo.GetRoom(ctx, req.RoomId)
+	if errors.Is(err, sql.ErrNoRows) || (err == nil && r.Kind != room.RoomKindGroup) {
+		return nil, status.Errorf(codes.NotFound, "group conversation does not exist")
+	}
+	if err != nil {
+		s.logger.Printf("Error getting room: %v", err)
+		return nil, status.Errorf(codes.Internal, "failed to get room")
+	}
+	if err := s.requireMember(ctx, req.RoomId, userID); err != nil {
+		return nil, err
+	}
+
+	if err := s.requireUsersExist(ctx, userIDs); err != nil {
+		return nil, err
+	}
+
+	added, err := s.repo.AddGroupMembers(ctx, req.RoomId, userIDs)
+	if errors.Is(err, room.ErrRoomNotFound) {
+		return nil, status.Errorf(codes.NotFound, "group conversation does not exist")
+	}
+	if errors.Is(err, room.ErrRoomFull) {
+		return nil, status.Errorf(codes.FailedPrecondition, "group conversation is full")
+	}
+	if err != nil {
+		s.logger.Printf("Error adding group members: %v", err)
+		return nil, status.Errorf(codes.Internal, "failed to add members")
+	}
+
+	g, err := s.repo.GetGroupConversation(ctx, req.RoomId)
+	if err != nil {
+		s.logger.Printf("Error getting group conversation: %v", err)
+		return nil, status.Errorf(codes.Internal, "failed to get group conversation")
+	}
+
+	if len(added) == 0 {
+		return &pb.AddGroupConversationMembersResponse{
+			Success:      false,
+			Message:      "users are already members of the conversation",
+			Conversation: toPbGroupConversation(g, userID),
+		}, nil
+	}
+
+	// Tell the conversation who was added. The members are already added, so
+	// a failure here is only logged.
+	if _, err := s.chat.SaveSystemMessage(ctx, addedMessage(g, userID, added), req.RoomId); err != nil {
+		s.logger.Printf("Error saving system message: %v", err)
+	}
+
+	return &pb.AddGroupConversationMembersResponse{
+		Success:      true,
+		Message:      "members added successfully",
+		Conversation: toPbGroupConversation(g, userID),
+	}, nil
+}
+
+// requireUsersExist checks that every user exists
+func (s *RoomService) requireUsersExist(ctx context.Context, userIDs []int64) error {
+	for _, id := range userIDs {
+		exists, err := s.repo.UserExists(ctx, id)
+		if err != nil {
+			s.logger.Printf("Error checking if user exists: %v", err)
+			return status.Errorf(codes.Internal, "failed to check if user exists")
+		}
+		if !exists {
+			return status.Errorf(codes.NotFound, "user %d does not exist", id)
+		}
+	}
+	return nil
+}
+
+// otherUserIDs returns userIDs without duplicates and without the user
+func otherUserIDs(userIDs []int64, userID int64) []int64 {
+	seen := map[int64]bool{userID: true}
+	var others []int64
+	for _, id := range userIDs {
+		if !seen[id] {
+			seen[id] = true
+			others = append(others, id)
+		}
+	}
+	return others
+}
+
+// addedMessage returns the system message announcing that userID added the
+// given users to a group conversation
+func addedMessage(g *room.GroupConversation, userID int64, added []int64) string {
+	names := make(map[int64]string, len(g.Participants))
+	for _, p := range g.Participants {
+		names[p.UserID] = p.Username
+	}
+
+	addedNames := make([]string, 0, len(added))
+	for _, id := range added {
+		addedNames = append(addedNames, names[id])
+	}
+	return fmt.Sprintf("%s added %s", names[userID], joinNames(addedNames, len(addedNames)))
+}
+
+// groupDisplayName derives a group conversation's name, as seen by userID,
+// from the other participants
+func groupDisplayName(g *room.GroupConversation, userID int64) string {
+	var names []string
+	for _, p := range g.Participants {
+		if p.UserID != userID {
+			names = append(names, p.Username)
+		}
+	}
+	return joinNames(names, displayNameParticipants)
+}
+
+// joinNames lists names in prose, e.g. "a, b and c", naming at most limit
+// of them and counting the rest, e.g. "a, b, c and 2 others"
+func joinNames(names []string, limit int) string {
+	switch {
+	case len(names) == 0:
+		return ""
+	case len(names) == 1:
+		return names[0]
+	case len(names) > limit:
+		rest := len(names) - limit
+		others := "others"
+		if rest == 1 {
+			others = "other"
+		}
+		return fmt.Sprintf("%s and %d %s", strings.Join(names[:limit], ", "), rest, others)
+	default:
+		return strings.Join(names[:len(names)-1], ", ") + " and " + names[len(names)-1]
+	}
+}
+
+// toPbGroupConversation converts a group conversation, as seen by userID, to
+// its protobuf form
+func toPbGroupConversation(g *room.GroupConversation, userID int64) *pb.GroupConversation {
+	participants := make([]*pb.GroupParticipant, 0, len(g.Participants))
+	for _, p := range g.Participants {
+		participants = append(participants, &pb.GroupParticipant{
+			UserId:   p.UserID,
+			Username: p.Username,
+		})
+	}
+
+	return &pb.GroupConversation{
+		RoomId:       g.RoomID,
+		DisplayName:  groupDisplayName(g, userID),
+		Participants: participants,
+		CreatedAt:    g.CreatedAt.Format(time.RFC3339),
+	}
+}
@@ -0,0 +1,264 @@
+package room
+
+import (
+	"context"
+	"crypto/rand"
+	"database/sql"
+	"encoding/base64"
+	"errors"
+	"time"
+
+	"grpc-messenger-core/db/room"
+	"grpc-messenger-core/internal/middleware"
+	pb "grpc-messenger-core/proto/room"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// inviteCodeBytes is the number of random bytes in an invite code
+const inviteCodeBytes = 9
+
+// CreateInviteCode creates a shareable invite code for a room. The caller must
+// be a room admin and outrank the role the code grants.
+func (s *RoomService) CreateInviteCode(ctx context.Context, req *pb.CreateInviteCodeRequest) (*pb.InviteCode, error) {
+	// Get the authenticated user
+	userID, err := middleware.CallerID(ctx, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	// Validate request
+	role := middleware.RoomRoleMember
+	if req.Role != "" {
+		role = middleware.RoomRole(req.Role)
+	}
+	if !role.Valid() || role == middleware.RoomRoleOwner {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid role %q", req.Role)
+	}
+	if req.MaxUses < 0 {
+		return nil, status.Errorf(codes.InvalidArgument, "max uses cannot be negative")
+	}
+	expiresAt, err := expiryFromDuration(req.ExpiresIn)
+	if err != nil {
+		return nil, err
+	}
+
+	code, err := generateInviteCode()
+	if err != nil {
+		s.logger.Printf("Error generating invite code: %v", err)
+		return nil, status.Errorf(codes.Internal, "failed to create invite code")
+	}
+
+	// For testing purposes, if db is nil, return a mock code
+	if s.db == nil {
+		s.logger.Println("Database connection is nil, returning mock invite code")
+		return toPbInviteCode(&room.InviteCode{
+			Code:      code,
+			RoomID:    req.RoomId,
+			CreatorID: userID,
+			Role:      string(role),
+			MaxUses:   req.MaxUses,
+			ExpiresAt: sql.NullTime{Time: expiresAt, Valid: !expiresAt.IsZero()},
+			CreatedAt: time.Now(),
+		}), nil
+	}
+
+	// Check the caller's permissions
+	callerRole, err := s.requireRoomAction(ctx, req.RoomId, userID, middleware.RoomActionManageInvites)
+	if err != nil {
+		return nil, err
+	}
+	if !callerRole.Outranks(role) {
+		return nil, status.Errorf(codes.PermissionDenied, "insufficient room role")
+	}
+
+	// Create the code
+	c, err := s.repo.CreateInviteCode(ctx, code, req.RoomId, userID, string(role), req.MaxUses, expiresAt)
+	if err != nil {
+		s.logger.Printf("Error creating invite code: %v", err)
+		return nil, status.Errorf(codes.Internal, "failed to create invite code")
+	}
+
+	return toPbInviteCode(c), nil
+}
+
+// ListInviteCodes retrieves the invite codes of a room together with the
+// users who joined through each of them
+func (s *RoomService) ListInviteCodes(ctx context.Context, req *pb.ListInviteCodesRequest) (*pb.ListInviteCodesResponse, error) {
+	// Get the authenticated user
+	userID, err := middleware.CallerID(ctx, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	// For testing purposes, if db is nil, return no codes
+	if s.db == nil {
+		s.logger.Println("Database connection is nil, returning mock invite codes")
+		return &pb.ListInviteCodesResponse{}, nil
+	}
+
+	// Check the caller's permissions
+	if _, err := s.requireRoomAction(ctx, req.RoomId, userID, middleware.RoomActionManageInvites); err != nil {
+		return nil, err
+	}
+
+	// Get codes from database
+	inviteCodes, err := s.repo.GetRoomInviteCodes(ctx, req.RoomId)
+	if err != nil {
+		s.logger.Printf("Error getting invite codes: %v", err)
+		return nil, status.Errorf(codes.Internal, "failed to get invite codes")
+	}
+	uses, err := s.repo.GetRoomInviteCodeUses(ctx, req.RoomId)
+	if err != nil {
+		s.logger.Printf("Error getting invite code uses: %v", err)
+		return nil, status.Errorf(codes.Internal, "failed to get invite codes")
+	}
+
+	// Convert to protobuf codes
+	pbCodes := make([]*pb.InviteCode, 0, len(inviteCodes))
+	byID := make(map[int64]*pb.InviteCode, len(inviteCodes))
+	for _, c := range inviteCodes {
+		pbCode := toPbInviteCode(c)
+		pbCodes = append(pbCodes, pbCode)
+		byID[c.ID] = pbCode
+	}
+	for _, u := range uses {
+		if pbCode, ok := byID[u.CodeID]; ok {
+			pbCode.UsedBy = append(pbCode.UsedBy, &pb.InviteCodeUse{
+				UserId:   u.UserID,
+				Username: u.Username,
+				UsedAt:   u.UsedAt.Format(time.RFC3339),
+			})
+		}
+	}
+
+	return &pb.ListInviteCodesResponse{
+		Codes: pbCodes,
+	}, nil
+}
+
+// RevokeInviteCode stops an invite code from being used
+func (s *RoomService) RevokeInviteCode(ctx context.Context, req *pb.RevokeInviteCodeRequest) (*pb.RevokeInviteCodeResponse, error) {
+	// Get the authenticated user
+	userID, err := middleware.CallerID(ctx, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	// For testing purposes, if db is nil, return success
+	if s.db == nil {
+		s.logger.Println("Database connection is nil, returning mock revoke invite code response")
+		return &pb.RevokeInviteCodeResponse{
+			Success: true,
+			Message: "invite code revoked successfully",
+		}, nil
+	}
+
+	// Check the caller's permissions
+	if _, err := s.requireRoomAction(ctx, req.RoomId, userID, middleware.RoomActionManageInvites); err != nil {
+		return nil, err
+	}
+
+	err = s.repo.RevokeInviteCode(ctx, req.RoomId, req.Code)
+	if errors.Is(err, room.ErrInviteCodeNotFound) {
+		return &pb.RevokeInviteCodeResponse{
+			Success: false,
+			Message: "invite code does not exist or is already revoked",
+		}, nil
+	}
+	if err != nil {
+		s.logger.Printf("Error revoking invite code: %v", err)
+		return nil, status.Errorf(codes.Internal, "failed to revoke invite code")
+	}
+
+	return &pb.RevokeInviteCodeResponse{
+		Success: true,
+		Message: "invite code revoked successfully",
+	}, nil
+}
+
+// JoinRoomByInvite joins the room an invite code belongs to, with the role
+// the code grants. Codes also work for private rooms.
+func (s *RoomService) JoinRoomByInvite(ctx context.Context, req *pb.JoinRoomByInviteRequest) (*pb.JoinRoomByInviteResponse, error) {
+	// Get the authenticated user
+	userID, err := middleware.CallerID(ctx, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	// Validate request
+	if req.Code == "" {
+		return nil, status.Errorf(codes.InvalidArgument, "invite code cannot be empty")
+	}
+
+	// For testing purposes, if db is nil, return success
+	if s.db == nil {
+		s.logger.Println("Database connection is nil, returning mock join by invite response")
+		return &pb.JoinRoomByInviteResponse{
+			Success: true,
+			Message: "user joined room successfully",
+			RoomId:  1,
+			Role:    string(middleware.RoomRoleMember),
+		}, nil
+	}
+
+	c, err := s.repo.RedeemInviteCode(ctx, req.Code, userID)
+	switch {
+	case errors.Is(err, room.ErrInviteCodeNotFound):
+		return nil, status.Errorf(codes.NotFound, "invite code does not exist")
+	case errors.Is(err, room.ErrInviteCodeExpired),
+		errors.Is(err, room.ErrInviteCodeRevoked),
+		errors.Is(err, room.ErrInviteCodeExhausted):
+		return nil, status.Errorf(codes.FailedPrecondition, "%v", err)
+	case errors.Is(err, room.ErrUserBanned):
+		return nil, status.Errorf(codes.PermissionDenied, "user is banned from the room")
+	case errors.Is(err, room.ErrRoomNotFound):
+		return nil, status.Errorf(codes.NotFound, "room does not exist")
+	case errors.Is(err, room.ErrRoomFull):
+		return nil, status.Errorf(codes.FailedPrecondition, "room is full")
+	case errors.Is(err, room.ErrAlreadyMember):
+		return &pb.JoinRoomByInviteResponse{
+			Success: false,
+			Message: "user is already a member of the room",
+		}, nil
+	case err != nil:
+		s.logger.Printf("Error redeeming invite code: %v", err)
+		return nil, status.Errorf(codes.Internal, "failed to join room")
+	}
+
+	return &pb.JoinRoomByInviteResponse{
+		Success: true,
+		Message: "user joined room successfully",
+		RoomId:  c.RoomID,
+		Role:    c.Role,
+	}, nil
+}
+
+// toPbInviteCode converts an invite code to its protobuf form
+func toPbInviteCode(c *room.InviteCode) *pb.InviteCode {
+	pbCode := &pb.InviteCode{
+		Id:        c.ID,
+		Code:      c.Code,
+		RoomId:    c.RoomID,
+		CreatorId: c.CreatorID,
+		Role:      c.Role,
+		MaxUses:   c.MaxUses,
+		Uses:      c.Uses,
+		Revoked:   c.RevokedAt.Valid,
+		CreatedAt: c.CreatedAt.Format(time.RFC3339),
+	}
+	if c.ExpiresAt.Valid {
+		pbCode.ExpiresAt = c.ExpiresAt.Time.Format(time.RFC3339)
+	}
+	return pbCode
+}
+
+// generateInviteCode returns a random URL-safe invite code
+func generateInviteCode() (string, error) {
+	b := make([]byte, inviteCodeBytes)
+	if _, err := rand.Read(b); err != nil {
+		return "", err
+	}
+	return base64.RawURLEncoding.EncodeToString(b), nil
+}
@@ -0,0 +1,254 @@
+package room
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"time"
+
+	"grpc-messenger-core/db/room"
+	"grpc-messenger-core/internal/middleware"
+	pb "grpc-messenger-core/proto/room"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// requestToJoin records a request to join a room that needs approval
+func (s *RoomService) requestToJoin(ctx context.Context, roomID, userID int64, message string) (*pb.JoinRoomResponse, error) {
+	banned, err := s.repo.IsBanned(ctx, roomID, userID)
+	if err != nil {
+		s.logger.Printf("Error checking room bans: %v", err)
+		return nil, status.Errorf(codes.Internal, "failed to check room bans")
+	}
+	if banned {
+		return nil, status.Errorf(codes.PermissionDenied, "user is banned from the room")
+	}
+
+	requestID, err := s.repo.CreateJoinRequest(ctx, roomID, userID, message)
+	if err != nil {
+		s.logger.Printf("Error creating join request: %v", err)
+		return nil, status.Errorf(codes.Internal, "failed to request to join room")
+	}
+
+	return &pb.JoinRoomResponse{
+		Success:       true,
+		Message:       "join request sent, waiting for approval",
+		Pending:       true,
+		JoinRequestId: requestID,
+	}, nil
+}
+
+// ListJoinRequests retrieves the pending join requests of a room. Only room
+// moderators can see them.
+func (s *RoomService) ListJoinRequests(ctx context.Context, req *pb.ListJoinRequestsRequest) (*pb.ListJoinRequestsResponse, error) {
+	// Get the authenticated user
+	userID, err := middleware.CallerID(ctx, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	// For testing purposes, if db is nil, return no join requests
+	if s.db == nil {
+		s.logger.Println("Database connection is nil, returning mock join requests")
+		return &pb.ListJoinRequestsResponse{}, nil
+	}
+
+	// Check the caller's permissions
+	if _, err := s.requireRoomAction(ctx, req.RoomId, userID, middleware.RoomActionApproveJoin); err != nil {
+		return nil, err
+	}
+
+	// Get join requests from database
+	requests, err := s.repo.GetRoomJoinRequests(ctx, req.RoomId)
+	if err != nil {
+		s.logger.Printf("Error getting join requests: %v", err)
+		return nil, status.Errorf(codes.Internal, "failed to get join requests")
+	}
+
+	return &pb.ListJoinRequestsResponse{
+		Requests: toPbJoinRequests(requests),
+	}, nil
+}
+
+// GetJoinRequests retrieves the join requests of the user, so they can see
+// whether they were approved or rejected
+func (s *RoomService) GetJoinRequests(ctx context.Context, req *pb.GetJoinRequestsRequest) (*pb.GetJoinRequestsResponse, error) {
+	// Get the authenticated user
+	userID, err := middleware.CallerID(ctx, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	// For testing purposes, if db is nil, return no join requests
+	if s.db == nil {
+		s.logger.Println("Database connection is nil, returning mock join requests")
+		return &pb.GetJoinRequestsResponse{}, nil
+	}
+
+	// Get join requests from database
+	requests, err := s.repo.GetUserJoinRequests(ctx, userID)
+	if err != nil {
+		s.logger.Printf("Error getting join requests: %v", err)
+		return nil, status.Errorf(codes.Internal, "failed to get join requests")
+	}
+
+	return &pb.GetJoinRequestsResponse{
+		Requests: toPbJoinRequests(requests),
+	}, nil
+}
+
+// ApproveJoinRequest approves a pending join request, adds the requester to
+// the room and tells them
+func (s *RoomService) ApproveJoinRequest(ctx context.Context, req *pb.ApproveJoinRequestRequest) (*pb.ApproveJoinRequestResponse, error) {
+	// Get the authenticated user
+	userID, err := middleware.CallerID(ctx, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	// For testing purposes, if db is nil, return success
+	if s.db == nil {
+		s.logger.Println("Database connection is nil, returning mock approve join request response")
+		return &pb.ApproveJoinRequestResponse{
+			Success: true,
+			Message: "join request approved successfully",
+		}, nil
+	}
+
+	// Check the caller's permissions
+	j, err := s.pendingJoinRequest(ctx, req.RequestId, userID)
+	if err != nil {
+		return nil, err
+	}
+
+	err = s.repo.ApproveJoinRequest(ctx, req.RequestId, userID)
+	if errors.Is(err, room.ErrJoinRequestNotFound) {
+		return &pb.ApproveJoinRequestResponse{
+			Success: false,
+			Message: "join request is no longer pending",
+		}, nil
+	}
+	if errors.Is(err, room.ErrUserBanned) {
+		return nil, status.Errorf(codes.FailedPrecondition, "user is banned from the room")
+	}
+	if errors.Is(err, room.ErrRoomNotFound) {
+		return nil, status.Errorf(codes.NotFound, "room does not exist")
+	}
+	if errors.Is(err, room.ErrRoomFull) {
+		return nil, status.Errorf(codes.FailedPrecondition, "room is full")
+	}
+	if err != nil {
+		s.logger.Printf("Error approving join request: %v", err)
+		return nil, status.Errorf(codes.Internal, "failed to approve join request")
+	}
+	s.notifyRequester(ctx, j, userID, true)
+
+	return &pb.ApproveJoinRequestResponse{
+		Success: true,
+		Message: "join request approved successfully",
+	}, nil
+}
+
+// RejectJoinRequest rejects a pending join request and tells the requester
+func (s *RoomService) RejectJoinRequest(ctx context.Context, req *pb.RejectJoinRequestRequest) (*pb.RejectJoinRequestResponse, error) {
+	// Get the authenticated user
+	userID, err := middleware.CallerID(ctx, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	// For testing purposes, if db is nil, return success
+	if s.db == nil {
+		s.logger.Println("Database connection is nil, returning mock reject join request response")
+		return &pb.RejectJoinRequestResponse{
+			Success: true,
+			Message: "join request rejected successfully",
+		}, nil
+	}
+
+	// Check the caller's permissions
+	j, err := s.pendingJoinRequest(ctx, req.RequestId, userID)
+	if err != nil {
+		return nil, err
+	}
+
+	err = s.repo.RejectJoinRequest(ctx, req.RequestId, userID)
+	if errors.Is(err, room.ErrJoinRequestNotFound) {
+		return &pb.RejectJoinRequestResponse{
+			Success: false,
+			Message: "join request is no longer pending",
+		}, nil
+	}
+	if err != nil {
+		s.logger.Printf("Error rejecting join request: %v", err)
+		return nil, status.Errorf(codes.Internal, "failed to reject join request")
+	}
+	s.notifyRequester(ctx, j, userID, false)
+
+	return &pb.RejectJoinRequestResponse{
+		Success: true,
+		Message: "join request rejected successfully",
+	}, nil
+}
+
+// notifyRequester tells the user who asked to join a room whether their
+// request was approved, with a system message in their direct conversation
+// with the moderator who decided. The requester is not a member of the room
+// yet, so its streams would not reach them. The decision is already saved,
+// so failures are only logged.
+func (s *RoomService) notifyRequester(ctx context.Context, j *room.JoinRequest, deciderID int64, approved bool) {
+	c, _, err := s.repo.OpenDirectConversation(ctx, deciderID, j.UserID)
+	if err != nil {
+		s.logger.Printf("Error opening direct conversation: %v", err)
+		return
+	}
+
+	content := fmt.Sprintf("Your request to join %s was rejected", j.RoomName)
+	if approved {
+		content = fmt.Sprintf("Your request to join %s was approved", j.RoomName)
+	}
+	if _, err := s.chat.SaveSystemMessage(ctx, content, c.RoomID); err != nil {
+		s.logger.Printf("Error saving system message: %v", err)
+	}
+}
+
+// pendingJoinRequest returns a join request the user may decide on
+func (s *RoomService) pendingJoinRequest(ctx context.Context, requestID, userID int64) (*room.JoinRequest, error) {
+	j, err := s.repo.GetJoinRequest(ctx, requestID)
+	if errors.Is(err, sql.ErrNoRows) {
+		return nil, status.Errorf(codes.NotFound, "join request does not exist")
+	}
+	if err != nil {
+		s.logger.Printf("Error getting join request: %v", err)
+		return nil, status.Errorf(codes.Internal, "failed to get join request")
+	}
+
+	if _, err := s.requireRoomAction(ctx, j.RoomID, userID, middleware.RoomActionApproveJoin); err != nil {
+		return nil, err
+	}
+	return j, nil
+}
+
+// toPbJoinRequests converts join requests to their protobuf form
+func toPbJoinRequests(requests []room.JoinRequest) []*pb.JoinRequest {
+	pbRequests := make([]*pb.JoinRequest, 0, len(requests))
+	for _, j := range requests {
+		pbRequest := &pb.JoinRequest{
+			Id:        j.ID,
+			RoomId:    j.RoomID,
+			RoomName:  j.RoomName,
+			UserId:    j.UserID,
+			Username:  j.Username,
+			Message:   j.Message,
+			Status:    j.Status,
+			CreatedAt: j.CreatedAt.Format(time.RFC3339),
+		}
+		if j.DecidedAt.Valid {
+			pbRequest.DecidedAt = j.DecidedAt.Time.Format(time.RFC3339)
+		}
+		pbRequests = append(pbRequests, pbRequest)
+	}
+	return pbRequests
+}
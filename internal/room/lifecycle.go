@@ -0,0 +1,247 @@
+package room
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"math"
+	"time"
+
+	"grpc-messenger-core/db/room"
+	"grpc-messenger-core/internal/middleware"
+	pb "grpc-messenger-core/proto/room"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// purgeInterval is how often deleted rooms are checked for purging
+const purgeInterval = time.Hour
+
+// UpdateRoom changes the name, description, topic, avatar, member limit or
+// join policy of a room
+func (s *RoomService) UpdateRoom(ctx context.Context, req *pb.UpdateRoomRequest) (*pb.RoomResponse, error) {
+	// Get the authenticated user
+	userID, err := middleware.CallerID(ctx, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	// Validate request
+	if req.Name != nil && *req.Name == "" {
+		return nil, status.Errorf(codes.InvalidArgument, "room name cannot be empty")
+	}
+	if req.MaxMembers != nil && *req.MaxMembers < 0 {
+		return nil, status.Errorf(codes.InvalidArgument, "max members cannot be negative")
+	}
+	if req.MaxMembers != nil && *req.MaxMembers > math.MaxInt32 {
+		return nil, status.Errorf(codes.InvalidArgument, "max members cannot exceed %d", math.MaxInt32)
+	}
+	if req.JoinPolicy != nil && !validJoinPolicy(*req.JoinPolicy) {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid join policy %q", *req.JoinPolicy)
+	}
+
+	// For testing purposes, if db is nil, update the mock room
+	if s.db == nil {
+		s.logger.Println("Database connection is nil, returning mock updated room")
+		for _, r := range s.mockRooms {
+			if r.Id == req.RoomId {
+				if r.IsPrivate && req.JoinPolicy != nil && *req.JoinPolicy == room.JoinPolicyOpen {
+					return nil, status.Errorf(codes.InvalidArgument, "private rooms cannot be open to join")
+				}
+				if req.Name != nil {
+					r.Name = *req.Name
+				}
+				if req.Description != nil {
+					r.Description = *req.Description
+				}
+				if req.Topic != nil {
+					r.Topic = *req.Topic
+				}
+				if req.AvatarUrl != nil {
+					r.AvatarUrl = *req.AvatarUrl
+				}
+				if req.MaxMembers != nil {
+					r.MaxMembers = *req.MaxMembers
+				}
+				if req.JoinPolicy != nil {
+					r.JoinPolicy = *req.JoinPolicy
+				}
+				return r, nil
+			}
+		}
+		return nil, status.Errorf(codes.NotFound, "room does not exist")
+	}
+
+	// Check the caller's permissions
+	if _, err := s.requireRoomAction(ctx, req.RoomId, userID, middleware.RoomActionUpdateRoom); err != nil {
+		return nil, err
+	}
+
+	// Private rooms cannot be opened to everyone
+	if req.JoinPolicy != nil && *req.JoinPolicy == room.JoinPolicyOpen {
+		current, err := s.repo.GetRoom(ctx, req.RoomId)
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, status.Errorf(codes.NotFound, "room does not exist")
+		}
+		if err != nil {
+			s.logger.Printf("Error getting room: %v", err)
+			return nil, status.Errorf(codes.Internal, "failed to get room")
+		}
+		if current.IsPrivate {
+			return nil, status.Errorf(codes.InvalidArgument, "private rooms cannot be open to join")
+		}
+	}
+
+	// Update the room
+	r, err := s.repo.UpdateRoom(ctx, req.RoomId, room.RoomUpdate{
+		Name:        req.Name,
+		Description: req.Description,
+		Topic:       req.Topic,
+		AvatarURL:   req.AvatarUrl,
+		MaxMembers:  req.MaxMembers,
+		JoinPolicy:  req.JoinPolicy,
+	})
+	if errors.Is(err, sql.ErrNoRows) {
+		return nil, status.Errorf(codes.NotFound, "room does not exist")
+	}
+	if err != nil {
+		s.logger.Printf("Error updating room: %v", err)
+		return nil, status.Errorf(codes.Internal, "failed to update room")
+	}
+
+	return toPbRoom(r), nil
+}
+
+// ArchiveRoom makes a room read-only. Members can still read its messages.
+func (s *RoomService) ArchiveRoom(ctx context.Context, req *pb.ArchiveRoomRequest) (*pb.ArchiveRoomResponse, error) {
+	if err := s.setArchived(ctx, req.RoomId, true); err != nil {
+		return nil, err
+	}
+
+	return &pb.ArchiveRoomResponse{
+		Success: true,
+		Message: "room archived successfully",
+	}, nil
+}
+
+// UnarchiveRoom makes an archived room writable again
+func (s *RoomService) UnarchiveRoom(ctx context.Context, req *pb.UnarchiveRoomRequest) (*pb.UnarchiveRoomResponse, error) {
+	if err := s.setArchived(ctx, req.RoomId, false); err != nil {
+		return nil, err
+	}
+
+	return &pb.UnarchiveRoomResponse{
+		Success: true,
+		Message: "room unarchived successfully",
+	}, nil
+}
+
+// setArchived archives or unarchives a room on behalf of the caller
+func (s *RoomService) setArchived(ctx context.Context, roomID int64, archived bool) error {
+	// Get the authenticated user
+	userID, err := middleware.CallerID(ctx, nil)
+	if err != nil {
+		return err
+	}
+
+	// For testing purposes, if db is nil, update the mock room
+	if s.db == nil {
+		s.logger.Println("Database connection is nil, returning mock archive response")
+		for _, r := range s.mockRooms {
+			if r.Id == roomID {
+				r.Archived = archived
+				break
+			}
+		}
+		return nil
+	}
+
+	// Check the caller's permissions
+	if _, err := s.requireRoomAction(ctx, roomID, userID, middleware.RoomActionUpdateRoom); err != nil {
+		return err
+	}
+
+	err = s.repo.SetRoomArchived(ctx, roomID, archived)
+	if errors.Is(err, room.ErrRoomNotFound) {
+		return status.Errorf(codes.NotFound, "room does not exist")
+	}
+	if err != nil {
+		s.logger.Printf("Error archiving room: %v", err)
+		return status.Errorf(codes.Internal, "failed to archive room")
+	}
+	return nil
+}
+
+// DeleteRoom deletes a room with its messages and members and ends open
+// streams on it. With a deleted room retention configured, the room is only
+// hidden and purged once the retention has passed.
+func (s *RoomService) DeleteRoom(ctx context.Context, req *pb.DeleteRoomRequest) (*pb.DeleteRoomResponse, error) {
+	// Get the authenticated user
+	userID, err := middleware.CallerID(ctx, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	// For testing purposes, if db is nil, remove the mock room
+	if s.db == nil {
+		s.logger.Println("Database connection is nil, returning mock delete room response")
+		for i, r := range s.mockRooms {
+			if r.Id == req.RoomId {
+				s.mockRooms = append(s.mockRooms[:i], s.mockRooms[i+1:]...)
+				break
+			}
+		}
+		return &pb.DeleteRoomResponse{
+			Success: true,
+			Message: "room deleted successfully",
+		}, nil
+	}
+
+	// Check the caller's permissions
+	if _, err := s.requireRoomAction(ctx, req.RoomId, userID, middleware.RoomActionDeleteRoom); err != nil {
+		return nil, err
+	}
+
+	// Delete the room
+	if s.config.DeletedRoomRetention > 0 {
+		err = s.repo.SoftDeleteRoom(ctx, req.RoomId)
+	} else {
+		err = s.repo.DeleteRoom(ctx, req.RoomId)
+	}
+	if err != nil {
+		s.logger.Printf("Error deleting room: %v", err)
+		return nil, status.Errorf(codes.Internal, "failed to delete room")
+	}
+
+	return &pb.DeleteRoomResponse{
+		Success: true,
+		Message: "room deleted successfully",
+	}, nil
+}
+
+// PurgeDeletedRooms permanently deletes soft-deleted rooms whose retention
+// has passed, checking every purgeInterval until ctx is done
+func (s *RoomService) PurgeDeletedRooms(ctx context.Context) {
+	if s.config.DeletedRoomRetention <= 0 {
+		return
+	}
+
+	ticker := time.NewTicker(purgeInterval)
+	defer ticker.Stop()
+
+	for {
+		n, err := s.repo.PurgeDeletedRooms(ctx, s.config.DeletedRoomRetention)
+		if err != nil {
+			s.logger.Printf("Error purging deleted rooms: %v", err)
+		} else if n > 0 {
+			s.logger.Printf("Purged %d deleted rooms", n)
+		}
+
+		select {
+		case <-ticker.C:
+		case <-ctx.Done():
+			return
+		}
+	}
+}
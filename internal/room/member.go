@@ -0,0 +1,167 @@
+package room
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"time"
+
+	"grpc-messenger-core/db/room"
+	"grpc-messenger-core/internal/middleware"
+	pb "grpc-messenger-core/proto/room"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// Default and maximum page size for ListRoomMembers
+const (
+	defaultListMembersLimit = 50
+	maxListMembersLimit     = 500
+)
+
+// GetRoom retrieves a room with its member count and the user's own
+// membership. Private rooms are only visible to members and invitees.
+func (s *RoomService) GetRoom(ctx context.Context, req *pb.GetRoomRequest) (*pb.GetRoomResponse, error) {
+	// Get the authenticated user
+	userID, err := middleware.CallerID(ctx, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	// For testing purposes, if db is nil, return the mock room
+	if s.db == nil {
+		s.logger.Println("Database connection is nil, returning mock room")
+		for _, r := range s.mockRooms {
+			if r.Id == req.RoomId {
+				return &pb.GetRoomResponse{Room: r}, nil
+			}
+		}
+		return nil, status.Errorf(codes.NotFound, "room does not exist")
+	}
+
+	r, membership, err := s.visibleRoom(ctx, req.RoomId, userID)
+	if err != nil {
+		return nil, err
+	}
+
+	count, err := s.repo.CountRoomMembers(ctx, req.RoomId)
+	if err != nil {
+		s.logger.Printf("Error counting room members: %v", err)
+		return nil, status.Errorf(codes.Internal, "failed to get room")
+	}
+
+	pbRoom := toPbRoom(r)
+	pbRoom.MemberCount = count
+	resp := &pb.GetRoomResponse{Room: pbRoom}
+	if membership != nil {
+		resp.Membership = toPbMember(membership)
+	}
+	return resp, nil
+}
+
+// ListRoomMembers retrieves the members of a room with their role and online
+// status. Members of private rooms are only visible to other members.
+func (s *RoomService) ListRoomMembers(ctx context.Context, req *pb.ListRoomMembersRequest) (*pb.ListRoomMembersResponse, error) {
+	// Get the authenticated user
+	userID, err := middleware.CallerID(ctx, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	// Set default values for limit and offset if not provided
+	limit := req.Limit
+	if limit <= 0 {
+		limit = defaultListMembersLimit
+	}
+	if limit > maxListMembersLimit {
+		limit = maxListMembersLimit
+	}
+	offset := req.Offset
+	if offset < 0 {
+		offset = 0
+	}
+
+	// For testing purposes, if db is nil, return no members
+	if s.db == nil {
+		s.logger.Println("Database connection is nil, returning mock room members")
+		return &pb.ListRoomMembersResponse{}, nil
+	}
+
+	r, membership, err := s.visibleRoom(ctx, req.RoomId, userID)
+	if err != nil {
+		return nil, err
+	}
+	if r.IsPrivate && membership == nil {
+		return nil, status.Errorf(codes.PermissionDenied, "user is not a member of the room")
+	}
+
+	// Get members from database
+	members, err := s.repo.ListRoomMembers(ctx, req.RoomId, limit, offset)
+	if err != nil {
+		s.logger.Printf("Error listing room members: %v", err)
+		return nil, status.Errorf(codes.Internal, "failed to list room members")
+	}
+	total, err := s.repo.CountRoomMembers(ctx, req.RoomId)
+	if err != nil {
+		s.logger.Printf("Error counting room members: %v", err)
+		return nil, status.Errorf(codes.Internal, "failed to list room members")
+	}
+
+	// Convert to protobuf members
+	pbMembers := make([]*pb.RoomMember, 0, len(members))
+	for i := range members {
+		pbMembers = append(pbMembers, toPbMember(&members[i]))
+	}
+
+	return &pb.ListRoomMembersResponse{
+		Members: pbMembers,
+		Total:   total,
+	}, nil
+}
+
+// visibleRoom returns a room the user may see, with their membership or nil
+// if they are not a member. Private rooms the user has no access to are
+// reported as not found.
+func (s *RoomService) visibleRoom(ctx context.Context, roomID, userID int64) (*room.Room, *room.Member, error) {
+	r, err := s.repo.GetRoom(ctx, roomID)
+	if errors.Is(err, sql.ErrNoRows) {
+		return nil, nil, status.Errorf(codes.NotFound, "room does not exist")
+	}
+	if err != nil {
+		s.logger.Printf("Error getting room: %v", err)
+		return nil, nil, status.Errorf(codes.Internal, "failed to get room")
+	}
+
+	membership, err := s.repo.GetMember(ctx, roomID, userID)
+	if errors.Is(err, sql.ErrNoRows) {
+		membership = nil
+	} else if err != nil {
+		s.logger.Printf("Error getting membership: %v", err)
+		return nil, nil, status.Errorf(codes.Internal, "failed to check room membership")
+	}
+
+	if r.IsPrivate && membership == nil {
+		invited, err := s.repo.HasPendingInvite(ctx, roomID, userID)
+		if err != nil {
+			s.logger.Printf("Error checking pending invites: %v", err)
+			return nil, nil, status.Errorf(codes.Internal, "failed to check pending invites")
+		}
+		if !invited {
+			return nil, nil, status.Errorf(codes.NotFound, "room does not exist")
+		}
+	}
+
+	return r, membership, nil
+}
+
+// toPbMember converts a room member to its protobuf form
+func toPbMember(m *room.Member) *pb.RoomMember {
+	return &pb.RoomMember{
+		UserId:   m.UserID,
+		Username: m.Username,
+		Role:     m.Role,
+		JoinedAt: m.JoinedAt.Format(time.RFC3339),
+		Online:   m.Online,
+	}
+}
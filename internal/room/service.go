@@ -3,14 +3,16 @@ package room
 import (
 	"context"
 	"database/sql"
+	"errors"
 	"log"
+	"math"
 
+	"grpc-messenger-core/db/chat"
 	"grpc-messenger-core/db/room"
 	"grpc-messenger-core/internal/middleware"
 	pb "grpc-messenger-core/proto/room"
 
 	"google.golang.org/grpc/codes"
-	"google.golang.org/grpc/metadata"
 	"google.golang.org/grpc/status"
 )
 
@@ -20,36 +22,54 @@ type RoomService struct {
 	db        *sql.DB
 	logger    *log.Logger
 	repo      *room.Repository
+	chat      *chat.Repository
+	config    Config
 	mockRooms []*pb.RoomResponse // For testing purposes
 }
 
 // NewRoomService creates a new room service
-func NewRoomService(db *sql.DB, logger *log.Logger) *RoomService {
+func NewRoomService(db *sql.DB, logger *log.Logger, config Config) *RoomService {
 	return &RoomService{
 		db:        db,
 		logger:    logger,
 		repo:      room.NewRepository(db),
+		chat:      chat.NewRepository(db),
+		config:    config,
 		mockRooms: make([]*pb.RoomResponse, 0),
 	}
 }
 
 // CreateRoom creates a new chat room
 func (s *RoomService) CreateRoom(ctx context.Context, req *pb.CreateRoomRequest) (*pb.RoomResponse, error) {
-	// Authenticate the user
-	userID, _, err := authenticateRequest(ctx)
+	// Get the authenticated user
+	userID, err := middleware.CallerID(ctx, req.CreatorId)
 	if err != nil {
 		return nil, err
 	}
 
-	// Verify the user ID matches the authenticated user
-	if userID != req.CreatorId {
-		return nil, status.Errorf(codes.PermissionDenied, "user ID does not match authenticated user")
-	}
-
 	// Validate request
 	if req.Name == "" {
 		return nil, status.Errorf(codes.InvalidArgument, "room name cannot be empty")
 	}
+	if req.MaxMembers < 0 {
+		return nil, status.Errorf(codes.InvalidArgument, "max members cannot be negative")
+	}
+	if req.MaxMembers > math.MaxInt32 {
+		return nil, status.Errorf(codes.InvalidArgument, "max members cannot exceed %d", math.MaxInt32)
+	}
+	joinPolicy := req.JoinPolicy
+	if joinPolicy == "" {
+		joinPolicy = room.JoinPolicyOpen
+		if req.IsPrivate {
+			joinPolicy = room.JoinPolicyInviteOnly
+		}
+	}
+	if !validJoinPolicy(joinPolicy) {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid join policy %q", req.JoinPolicy)
+	}
+	if req.IsPrivate && joinPolicy == room.JoinPolicyOpen {
+		return nil, status.Errorf(codes.InvalidArgument, "private rooms cannot be open to join")
+	}
 
 	// For testing purposes, if db is nil, return a mock room
 	if s.db == nil {
@@ -61,7 +81,11 @@ func (s *RoomService) CreateRoom(ctx context.Context, req *pb.CreateRoomRequest)
 			Id:          mockRoomID,
 			Name:        req.Name,
 			Description: req.Description,
-			CreatorId:   req.CreatorId,
+			CreatorId:   userID,
+			IsPrivate:   req.IsPrivate,
+			MaxMembers:  req.MaxMembers,
+			JoinPolicy:  joinPolicy,
+			Kind:        room.RoomKindRoom,
 		}
 
 		// Add the mock room to our in-memory store
@@ -71,14 +95,21 @@ func (s *RoomService) CreateRoom(ctx context.Context, req *pb.CreateRoomRequest)
 	}
 
 	// Create room in database
-	roomID, err := s.repo.CreateRoom(ctx, req.Name, req.Description, req.CreatorId)
+	roomID, err := s.repo.CreateRoom(ctx, room.Room{
+		Name:        req.Name,
+		Description: req.Description,
+		CreatorID:   userID,
+		IsPrivate:   req.IsPrivate,
+		MaxMembers:  req.MaxMembers,
+		JoinPolicy:  joinPolicy,
+	})
 	if err != nil {
 		s.logger.Printf("Error creating room: %v", err)
 		return nil, status.Errorf(codes.Internal, "failed to create room")
 	}
 
-	// Add creator as a member
-	err = s.repo.AddRoomMember(ctx, roomID, req.CreatorId)
+	// Add creator as the owner
+	err = s.repo.AddRoomMember(ctx, roomID, userID, string(middleware.RoomRoleOwner))
 	if err != nil {
 		s.logger.Printf("Error adding creator as member: %v", err)
 		return nil, status.Errorf(codes.Internal, "failed to add creator as member")
@@ -88,23 +119,23 @@ func (s *RoomService) CreateRoom(ctx context.Context, req *pb.CreateRoomRequest)
 		Id:          roomID,
 		Name:        req.Name,
 		Description: req.Description,
-		CreatorId:   req.CreatorId,
+		CreatorId:   userID,
+		IsPrivate:   req.IsPrivate,
+		MaxMembers:  req.MaxMembers,
+		JoinPolicy:  joinPolicy,
+		Kind:        room.RoomKindRoom,
 	}, nil
 }
 
-// GetRooms retrieves all rooms the user is a member of
+// GetRooms retrieves all rooms the user is a member of, with their direct and
+// group conversations listed separately
 func (s *RoomService) GetRooms(ctx context.Context, req *pb.GetRoomsRequest) (*pb.GetRoomsResponse, error) {
-	// Authenticate the user
-	userID, _, err := authenticateRequest(ctx)
+	// Get the authenticated user
+	userID, err := middleware.CallerID(ctx, req.UserId)
 	if err != nil {
 		return nil, err
 	}
 
-	// Verify the user ID matches the authenticated user
-	if userID != req.UserId {
-		return nil, status.Errorf(codes.PermissionDenied, "user ID does not match authenticated user")
-	}
-
 	// For testing purposes, if db is nil, return mock rooms
 	if s.db == nil {
 		s.logger.Println("Database connection is nil, returning mock rooms")
@@ -115,7 +146,8 @@ func (s *RoomService) GetRooms(ctx context.Context, req *pb.GetRoomsRequest) (*p
 				Id:          1,
 				Name:        "General",
 				Description: "General chat room",
-				CreatorId:   req.UserId,
+				CreatorId:   userID,
+				Kind:        room.RoomKindRoom,
 			},
 		}
 
@@ -124,7 +156,7 @@ func (s *RoomService) GetRooms(ctx context.Context, req *pb.GetRoomsRequest) (*p
 			// Add the mock rooms to the response
 			for _, room := range s.mockRooms {
 				// Only include rooms where the user is a member
-				if room.CreatorId == req.UserId {
+				if room.CreatorId == userID {
 					mockRooms = append(mockRooms, room)
 				}
 			}
@@ -136,41 +168,52 @@ func (s *RoomService) GetRooms(ctx context.Context, req *pb.GetRoomsRequest) (*p
 	}
 
 	// Get rooms from database
-	rooms, err := s.repo.GetUserRooms(ctx, req.UserId)
+	rooms, err := s.repo.GetUserRooms(ctx, userID)
 	if err != nil {
 		s.logger.Printf("Error getting rooms: %v", err)
 		return nil, status.Errorf(codes.Internal, "failed to get rooms")
 	}
 
+	conversations, err := s.repo.GetUserDirectConversations(ctx, userID)
+	if err != nil {
+		s.logger.Printf("Error getting direct conversations: %v", err)
+		return nil, status.Errorf(codes.Internal, "failed to get rooms")
+	}
+	groups, err := s.repo.GetUserGroupConversations(ctx, userID)
+	if err != nil {
+		s.logger.Printf("Error getting group conversations: %v", err)
+		return nil, status.Errorf(codes.Internal, "failed to get rooms")
+	}
+
 	// Convert to protobuf rooms
 	pbRooms := make([]*pb.RoomResponse, 0, len(rooms))
-	for _, r := range rooms {
-		pbRooms = append(pbRooms, &pb.RoomResponse{
-			Id:          r.ID,
-			Name:        r.Name,
-			Description: r.Description,
-			CreatorId:   r.CreatorID,
-		})
+	for i := range rooms {
+		pbRooms = append(pbRooms, toPbRoom(&rooms[i]))
+	}
+	pbConversations := make([]*pb.DirectConversation, 0, len(conversations))
+	for i := range conversations {
+		pbConversations = append(pbConversations, toPbDirectConversation(&conversations[i]))
+	}
+	pbGroups := make([]*pb.GroupConversation, 0, len(groups))
+	for i := range groups {
+		pbGroups = append(pbGroups, toPbGroupConversation(&groups[i], userID))
 	}
 
 	return &pb.GetRoomsResponse{
-		Rooms: pbRooms,
+		Rooms:               pbRooms,
+		DirectConversations: pbConversations,
+		GroupConversations:  pbGroups,
 	}, nil
 }
 
 // JoinRoom adds a user to a room
 func (s *RoomService) JoinRoom(ctx context.Context, req *pb.JoinRoomRequest) (*pb.JoinRoomResponse, error) {
-	// Authenticate the user
-	userID, _, err := authenticateRequest(ctx)
+	// Get the authenticated user
+	userID, err := middleware.CallerID(ctx, req.UserId)
 	if err != nil {
 		return nil, err
 	}
 
-	// Verify the user ID matches the authenticated user
-	if userID != req.UserId {
-		return nil, status.Errorf(codes.PermissionDenied, "user ID does not match authenticated user")
-	}
-
 	// For testing purposes, if db is nil, return success
 	if s.db == nil {
 		s.logger.Println("Database connection is nil, returning mock join response")
@@ -180,21 +223,22 @@ func (s *RoomService) JoinRoom(ctx context.Context, req *pb.JoinRoomRequest) (*p
 		}, nil
 	}
 
-	// Check if room exists
-	roomExists, err := s.repo.RoomExists(ctx, req.RoomId)
-	if err != nil {
-		s.logger.Printf("Error checking if room exists: %v", err)
-		return nil, status.Errorf(codes.Internal, "failed to check if room exists")
-	}
-	if !roomExists {
+	// Check if room exists. Direct and group conversations cannot be joined
+	// this way.
+	r, err := s.repo.GetRoom(ctx, req.RoomId)
+	if errors.Is(err, sql.ErrNoRows) || (err == nil && r.Kind != room.RoomKindRoom) {
 		return &pb.JoinRoomResponse{
 			Success: false,
 			Message: "room does not exist",
 		}, nil
 	}
+	if err != nil {
+		s.logger.Printf("Error checking if room exists: %v", err)
+		return nil, status.Errorf(codes.Internal, "failed to check if room exists")
+	}
 
 	// Check if user is already a member
-	isMember, err := s.repo.IsRoomMember(ctx, req.RoomId, req.UserId)
+	isMember, err := s.repo.IsRoomMember(ctx, req.RoomId, userID)
 	if err != nil {
 		s.logger.Printf("Error checking room membership: %v", err)
 		return nil, status.Errorf(codes.Internal, "failed to check room membership")
@@ -206,8 +250,43 @@ func (s *RoomService) JoinRoom(ctx context.Context, req *pb.JoinRoomRequest) (*p
 		}, nil
 	}
 
-	// Add user to room
-	err = s.repo.AddRoomMember(ctx, req.RoomId, req.UserId)
+	// Private rooms are invite-only whatever their join policy
+	joinPolicy := r.JoinPolicy
+	if r.IsPrivate && joinPolicy == room.JoinPolicyOpen {
+		joinPolicy = room.JoinPolicyInviteOnly
+	}
+
+	switch joinPolicy {
+	case room.JoinPolicyInviteOnly:
+		// Invite-only rooms can only be joined with an invite
+		err = s.repo.AcceptRoomInvite(ctx, req.RoomId, userID)
+		if errors.Is(err, room.ErrInviteNotFound) {
+			return nil, status.Errorf(codes.PermissionDenied, "room requires an invite")
+		}
+	case room.JoinPolicyApproval:
+		// An invite counts as approval, otherwise a moderator has to decide
+		err = s.repo.AcceptRoomInvite(ctx, req.RoomId, userID)
+		if errors.Is(err, room.ErrInviteNotFound) {
+			return s.requestToJoin(ctx, req.RoomId, userID, req.Message)
+		}
+	default:
+		err = s.repo.AddRoomMember(ctx, req.RoomId, userID, string(middleware.RoomRoleMember))
+	}
+	if errors.Is(err, room.ErrUserBanned) {
+		return nil, status.Errorf(codes.PermissionDenied, "user is banned from the room")
+	}
+	if errors.Is(err, room.ErrRoomNotFound) {
+		return nil, status.Errorf(codes.NotFound, "room does not exist")
+	}
+	if errors.Is(err, room.ErrRoomFull) {
+		return nil, status.Errorf(codes.FailedPrecondition, "room is full")
+	}
+	if errors.Is(err, room.ErrNotJoinable) {
+		return nil, status.Errorf(codes.FailedPrecondition, "direct conversations cannot be joined")
+	}
+	if errors.Is(err, room.ErrAlreadyMember) {
+		return nil, status.Errorf(codes.AlreadyExists, "user is already a member of the room")
+	}
 	if err != nil {
 		s.logger.Printf("Error adding user to room: %v", err)
 		return nil, status.Errorf(codes.Internal, "failed to add user to room")
@@ -221,17 +300,12 @@ func (s *RoomService) JoinRoom(ctx context.Context, req *pb.JoinRoomRequest) (*p
 
 // LeaveRoom removes a user from a room
 func (s *RoomService) LeaveRoom(ctx context.Context, req *pb.LeaveRoomRequest) (*pb.LeaveRoomResponse, error) {
-	// Authenticate the user
-	userID, _, err := authenticateRequest(ctx)
+	// Get the authenticated user
+	userID, err := middleware.CallerID(ctx, req.UserId)
 	if err != nil {
 		return nil, err
 	}
 
-	// Verify the user ID matches the authenticated user
-	if userID != req.UserId {
-		return nil, status.Errorf(codes.PermissionDenied, "user ID does not match authenticated user")
-	}
-
 	// For testing purposes, if db is nil, return success
 	if s.db == nil {
 		s.logger.Println("Database connection is nil, returning mock leave response")
@@ -242,20 +316,40 @@ func (s *RoomService) LeaveRoom(ctx context.Context, req *pb.LeaveRoomRequest) (
 	}
 
 	// Check if user is a member
-	isMember, err := s.repo.IsRoomMember(ctx, req.RoomId, req.UserId)
+	role, err := s.memberRole(ctx, req.RoomId, userID)
 	if err != nil {
-		s.logger.Printf("Error checking room membership: %v", err)
-		return nil, status.Errorf(codes.Internal, "failed to check room membership")
+		return nil, err
 	}
-	if !isMember {
+	if role == "" {
 		return &pb.LeaveRoomResponse{
 			Success: false,
 			Message: "user is not a member of the room",
 		}, nil
 	}
 
+	// Both members of a direct conversation stay in it
+	r, err := s.repo.GetRoom(ctx, req.RoomId)
+	if err != nil && !errors.Is(err, sql.ErrNoRows) {
+		s.logger.Printf("Error getting room: %v", err)
+		return nil, status.Errorf(codes.Internal, "failed to get room")
+	}
+	if err == nil && r.Kind == room.RoomKindDirect {
+		return &pb.LeaveRoomResponse{
+			Success: false,
+			Message: "cannot leave a direct conversation",
+		}, nil
+	}
+
+	// The owner has to hand the room over first
+	if role == middleware.RoomRoleOwner {
+		return &pb.LeaveRoomResponse{
+			Success: false,
+			Message: "the room owner must transfer ownership before leaving",
+		}, nil
+	}
+
 	// Remove user from room
-	err = s.repo.RemoveRoomMember(ctx, req.RoomId, req.UserId)
+	err = s.repo.RemoveRoomMember(ctx, req.RoomId, userID)
 	if err != nil {
 		s.logger.Printf("Error removing user from room: %v", err)
 		return nil, status.Errorf(codes.Internal, "failed to remove user from room")
@@ -267,30 +361,196 @@ func (s *RoomService) LeaveRoom(ctx context.Context, req *pb.LeaveRoomRequest) (
 	}, nil
 }
 
-// Helper function to authenticate a request
-func authenticateRequest(ctx context.Context) (int64, string, error) {
-	md, ok := metadata.FromIncomingContext(ctx)
-	if !ok {
-		return 0, "", status.Errorf(codes.Unauthenticated, "metadata is not provided")
+// SetMemberRole changes the role of a room member. The caller must be a room
+// admin and outrank both the member's current and new role.
+func (s *RoomService) SetMemberRole(ctx context.Context, req *pb.SetMemberRoleRequest) (*pb.SetMemberRoleResponse, error) {
+	// Get the authenticated user
+	userID, err := middleware.CallerID(ctx, nil)
+	if err != nil {
+		return nil, err
 	}
 
-	authHeader := md.Get("authorization")
-	if len(authHeader) == 0 {
-		return 0, "", status.Errorf(codes.Unauthenticated, "authorization token is not provided")
+	// Validate request
+	newRole := middleware.RoomRole(req.Role)
+	if !newRole.Valid() || newRole == middleware.RoomRoleOwner {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid role %q", req.Role)
+	}
+	if req.UserId == userID {
+		return nil, status.Errorf(codes.InvalidArgument, "cannot change your own role")
 	}
 
-	// Extract token from "Bearer <token>"
-	token := authHeader[0]
-	if len(token) <= 7 || token[:7] != "Bearer " {
-		return 0, "", status.Errorf(codes.Unauthenticated, "invalid authorization format")
+	// For testing purposes, if db is nil, return success
+	if s.db == nil {
+		s.logger.Println("Database connection is nil, returning mock set member role response")
+		return &pb.SetMemberRoleResponse{
+			Success: true,
+			Message: "member role updated successfully",
+		}, nil
 	}
-	token = token[7:]
 
-	// Validate token
-	claims, err := middleware.ValidateToken(token)
+	// Check the caller's permissions
+	callerRole, err := s.requireRoomAction(ctx, req.RoomId, userID, middleware.RoomActionManageRoles)
 	if err != nil {
-		return 0, "", status.Errorf(codes.Unauthenticated, "invalid token: %v", err)
+		return nil, err
 	}
 
-	return claims.UserID, claims.Username, nil
+	// Check the member's current role
+	targetRole, err := s.memberRole(ctx, req.RoomId, req.UserId)
+	if err != nil {
+		return nil, err
+	}
+	if targetRole == "" {
+		return &pb.SetMemberRoleResponse{
+			Success: false,
+			Message: "user is not a member of the room",
+		}, nil
+	}
+	if !callerRole.Outranks(targetRole) || !callerRole.Outranks(newRole) {
+		return nil, status.Errorf(codes.PermissionDenied, "insufficient room role")
+	}
+
+	// Update the role
+	err = s.repo.SetMemberRole(ctx, req.RoomId, req.UserId, string(newRole))
+	if err != nil {
+		s.logger.Printf("Error setting member role: %v", err)
+		return nil, status.Errorf(codes.Internal, "failed to set member role")
+	}
+
+	return &pb.SetMemberRoleResponse{
+		Success: true,
+		Message: "member role updated successfully",
+	}, nil
+}
+
+// TransferOwnership makes another member the owner of a room. The previous
+// owner becomes a room admin.
+func (s *RoomService) TransferOwnership(ctx context.Context, req *pb.TransferOwnershipRequest) (*pb.TransferOwnershipResponse, error) {
+	// Get the authenticated user
+	userID, err := middleware.CallerID(ctx, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	// Validate request
+	if req.NewOwnerId == userID {
+		return nil, status.Errorf(codes.InvalidArgument, "user already owns the room")
+	}
+
+	// For testing purposes, if db is nil, return success
+	if s.db == nil {
+		s.logger.Println("Database connection is nil, returning mock transfer ownership response")
+		return &pb.TransferOwnershipResponse{
+			Success: true,
+			Message: "ownership transferred successfully",
+		}, nil
+	}
+
+	// Check that the caller owns the room
+	callerRole, err := s.memberRole(ctx, req.RoomId, userID)
+	if err != nil {
+		return nil, err
+	}
+	if callerRole != middleware.RoomRoleOwner {
+		return nil, status.Errorf(codes.PermissionDenied, "only the room owner can transfer ownership")
+	}
+
+	// Check that the new owner is a member
+	targetRole, err := s.memberRole(ctx, req.RoomId, req.NewOwnerId)
+	if err != nil {
+		return nil, err
+	}
+	if targetRole == "" {
+		return &pb.TransferOwnershipResponse{
+			Success: false,
+			Message: "new owner is not a member of the room",
+		}, nil
+	}
+
+	// Transfer ownership
+	err = s.repo.TransferOwnership(ctx, req.RoomId, userID, req.NewOwnerId)
+	if errors.Is(err, room.ErrNotOwner) {
+		return nil, status.Errorf(codes.PermissionDenied, "only the room owner can transfer ownership")
+	}
+	if errors.Is(err, room.ErrNewOwnerAbsent) {
+		return &pb.TransferOwnershipResponse{
+			Success: false,
+			Message: "new owner is not a member of the room",
+		}, nil
+	}
+	if err != nil {
+		s.logger.Printf("Error transferring ownership: %v", err)
+		return nil, status.Errorf(codes.Internal, "failed to transfer ownership")
+	}
+
+	return &pb.TransferOwnershipResponse{
+		Success: true,
+		Message: "ownership transferred successfully",
+	}, nil
+}
+
+// toPbRoom converts a room to its protobuf form
+func toPbRoom(r *room.Room) *pb.RoomResponse {
+	return &pb.RoomResponse{
+		Id:          r.ID,
+		Name:        r.Name,
+		Description: r.Description,
+		CreatorId:   r.CreatorID,
+		IsPrivate:   r.IsPrivate,
+		Topic:       r.Topic,
+		AvatarUrl:   r.AvatarURL,
+		Archived:    r.Archived,
+		MaxMembers:  r.MaxMembers,
+		JoinPolicy:  r.JoinPolicy,
+		Kind:        r.Kind,
+	}
+}
+
+// validJoinPolicy reports whether policy is a known join policy
+func validJoinPolicy(policy string) bool {
+	switch policy {
+	case room.JoinPolicyOpen, room.JoinPolicyApproval, room.JoinPolicyInviteOnly:
+		return true
+	}
+	return false
+}
+
+// memberRole returns a user's role in a room, or "" if they are not a member
+func (s *RoomService) memberRole(ctx context.Context, roomID, userID int64) (middleware.RoomRole, error) {
+	role, err := s.repo.GetMemberRole(ctx, roomID, userID)
+	if errors.Is(err, sql.ErrNoRows) {
+		return "", nil
+	}
+	if err != nil {
+		s.logger.Printf("Error getting member role: %v", err)
+		return "", status.Errorf(codes.Internal, "failed to check room membership")
+	}
+	return middleware.RoomRole(role), nil
+}
+
+// requireMember checks that a user is a member of a room
+func (s *RoomService) requireMember(ctx context.Context, roomID, userID int64) error {
+	role, err := s.memberRole(ctx, roomID, userID)
+	if err != nil {
+		return err
+	}
+	if role == "" {
+		return status.Errorf(codes.PermissionDenied, "user is not a member of the room")
+	}
+	return nil
+}
+
+// requireRoomAction checks that a user's room role allows an action and
+// returns the role
+func (s *RoomService) requireRoomAction(ctx context.Context, roomID, userID int64, action middleware.RoomAction) (middleware.RoomRole, error) {
+	role, err := s.memberRole(ctx, roomID, userID)
+	if err != nil {
+		return "", err
+	}
+	if role == "" {
+		return "", status.Errorf(codes.PermissionDenied, "user is not a member of the room")
+	}
+	if !role.Can(action) {
+		return "", status.Errorf(codes.PermissionDenied, "insufficient room role")
+	}
+	return role, nil
 }
@@ -24,10 +24,18 @@ const (
 
 // Request to send a message
 type SendMessageRequest struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Content       string                 `protobuf:"bytes,1,opt,name=content,proto3" json:"content,omitempty"`
-	SenderId      int64                  `protobuf:"varint,2,opt,name=sender_id,json=senderId,proto3" json:"sender_id,omitempty"`
-	RoomId        int64                  `protobuf:"varint,3,opt,name=room_id,json=roomId,proto3" json:"room_id,omitempty"`
+	state   protoimpl.MessageState `protogen:"open.v1"`
+	Content string                 `protobuf:"bytes,1,opt,name=content,proto3" json:"content,omitempty"`
+	// Deprecated: the sender is taken from the access token. If set, it must
+	// match the authenticated user.
+	//
+	// Deprecated: Marked as deprecated in proto/chat/chat.proto.
+	SenderId *int64 `protobuf:"varint,2,opt,name=sender_id,json=senderId,proto3,oneof" json:"sender_id,omitempty"`
+	RoomId   int64  `protobuf:"varint,3,opt,name=room_id,json=roomId,proto3" json:"room_id,omitempty"`
+	// To reply in a thread, the first message of the thread or any reply in it
+	ParentMessageId int64 `protobuf:"varint,4,opt,name=parent_message_id,json=parentMessageId,proto3" json:"parent_message_id,omitempty"`
+	// To quote an earlier message of the room inline
+	ReplyToId     int64 `protobuf:"varint,5,opt,name=reply_to_id,json=replyToId,proto3" json:"reply_to_id,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
@@ -69,9 +77,10 @@ func (x *SendMessageRequest) GetContent() string {
 	return ""
 }
 
+// Deprecated: Marked as deprecated in proto/chat/chat.proto.
 func (x *SendMessageRequest) GetSenderId() int64 {
-	if x != nil {
-		return x.SenderId
+	if x != nil && x.SenderId != nil {
+		return *x.SenderId
 	}
 	return 0
 }
@@ -83,6 +92,20 @@ func (x *SendMessageRequest) GetRoomId() int64 {
 	return 0
 }
 
+func (x *SendMessageRequest) GetParentMessageId() int64 {
+	if x != nil {
+		return x.ParentMessageId
+	}
+	return 0
+}
+
+func (x *SendMessageRequest) GetReplyToId() int64 {
+	if x != nil {
+		return x.ReplyToId
+	}
+	return 0
+}
+
 // Response to a send message request
 type SendMessageResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
@@ -146,11 +169,15 @@ func (x *SendMessageResponse) GetMessageId() int64 {
 
 // Request to get messages from a room
 type GetRoomMessagesRequest struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	RoomId        int64                  `protobuf:"varint,1,opt,name=room_id,json=roomId,proto3" json:"room_id,omitempty"`
-	UserId        int64                  `protobuf:"varint,2,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
-	Limit         int64                  `protobuf:"varint,3,opt,name=limit,proto3" json:"limit,omitempty"`
-	Offset        int64                  `protobuf:"varint,4,opt,name=offset,proto3" json:"offset,omitempty"`
+	state  protoimpl.MessageState `protogen:"open.v1"`
+	RoomId int64                  `protobuf:"varint,1,opt,name=room_id,json=roomId,proto3" json:"room_id,omitempty"`
+	// Deprecated: the user is taken from the access token. If set, it must
+	// match the authenticated user.
+	//
+	// Deprecated: Marked as deprecated in proto/chat/chat.proto.
+	UserId        *int64 `protobuf:"varint,2,opt,name=user_id,json=userId,proto3,oneof" json:"user_id,omitempty"`
+	Limit         int64  `protobuf:"varint,3,opt,name=limit,proto3" json:"limit,omitempty"`
+	Offset        int64  `protobuf:"varint,4,opt,name=offset,proto3" json:"offset,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
@@ -192,9 +219,10 @@ func (x *GetRoomMessagesRequest) GetRoomId() int64 {
 	return 0
 }
 
+// Deprecated: Marked as deprecated in proto/chat/chat.proto.
 func (x *GetRoomMessagesRequest) GetUserId() int64 {
-	if x != nil {
-		return x.UserId
+	if x != nil && x.UserId != nil {
+		return *x.UserId
 	}
 	return 0
 }
@@ -213,7 +241,8 @@ func (x *GetRoomMessagesRequest) GetOffset() int64 {
 	return 0
 }
 
-// Response to a get messages request
+// Response to a get messages request. Thread replies are left out, see
+// GetThread.
 type GetRoomMessagesResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Messages      []*MessageResponse     `protobuf:"bytes,1,rep,name=messages,proto3" json:"messages,omitempty"`
@@ -260,9 +289,22 @@ func (x *GetRoomMessagesResponse) GetMessages() []*MessageResponse {
 
 // Request to stream messages from a room
 type StreamRoomMessagesRequest struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	RoomId        int64                  `protobuf:"varint,1,opt,name=room_id,json=roomId,proto3" json:"room_id,omitempty"`
-	UserId        int64                  `protobuf:"varint,2,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	state  protoimpl.MessageState `protogen:"open.v1"`
+	RoomId int64                  `protobuf:"varint,1,opt,name=room_id,json=roomId,proto3" json:"room_id,omitempty"`
+	// Deprecated: the user is taken from the access token. If set, it must
+	// match the authenticated user.
+	//
+	// Deprecated: Marked as deprecated in proto/chat/chat.proto.
+	UserId *int64 `protobuf:"varint,2,opt,name=user_id,json=userId,proto3,oneof" json:"user_id,omitempty"`
+	// To resume a stream, set one of these to the last message received.
+	// Messages sent after it are replayed before live delivery starts.
+	SinceMessageId int64  `protobuf:"varint,3,opt,name=since_message_id,json=sinceMessageId,proto3" json:"since_message_id,omitempty"`
+	Cursor         string `protobuf:"bytes,4,opt,name=cursor,proto3" json:"cursor,omitempty"`
+	// To follow a single thread, the ID of its first message. Only that
+	// message and its replies are streamed. Otherwise thread replies are left
+	// out, as in GetRoomMessages; the first message of a thread is sent as an
+	// edit when a reply changes its reply count.
+	ThreadId      int64 `protobuf:"varint,5,opt,name=thread_id,json=threadId,proto3" json:"thread_id,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
@@ -304,24 +346,73 @@ func (x *StreamRoomMessagesRequest) GetRoomId() int64 {
 	return 0
 }
 
+// Deprecated: Marked as deprecated in proto/chat/chat.proto.
 func (x *StreamRoomMessagesRequest) GetUserId() int64 {
+	if x != nil && x.UserId != nil {
+		return *x.UserId
+	}
+	return 0
+}
+
+func (x *StreamRoomMessagesRequest) GetSinceMessageId() int64 {
 	if x != nil {
-		return x.UserId
+		return x.SinceMessageId
+	}
+	return 0
+}
+
+func (x *StreamRoomMessagesRequest) GetCursor() string {
+	if x != nil {
+		return x.Cursor
+	}
+	return ""
+}
+
+func (x *StreamRoomMessagesRequest) GetThreadId() int64 {
+	if x != nil {
+		return x.ThreadId
 	}
 	return 0
 }
 
 // Message response
 type MessageResponse struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Id            int64                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
-	Content       string                 `protobuf:"bytes,2,opt,name=content,proto3" json:"content,omitempty"`
-	SenderId      int64                  `protobuf:"varint,3,opt,name=sender_id,json=senderId,proto3" json:"sender_id,omitempty"`
-	RoomId        int64                  `protobuf:"varint,4,opt,name=room_id,json=roomId,proto3" json:"room_id,omitempty"`
-	SenderName    string                 `protobuf:"bytes,5,opt,name=sender_name,json=senderName,proto3" json:"sender_name,omitempty"`
-	Timestamp     string                 `protobuf:"bytes,6,opt,name=timestamp,proto3" json:"timestamp,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+	state      protoimpl.MessageState `protogen:"open.v1"`
+	Id         int64                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	Content    string                 `protobuf:"bytes,2,opt,name=content,proto3" json:"content,omitempty"`
+	SenderId   int64                  `protobuf:"varint,3,opt,name=sender_id,json=senderId,proto3" json:"sender_id,omitempty"`
+	RoomId     int64                  `protobuf:"varint,4,opt,name=room_id,json=roomId,proto3" json:"room_id,omitempty"`
+	SenderName string                 `protobuf:"bytes,5,opt,name=sender_name,json=senderName,proto3" json:"sender_name,omitempty"`
+	Timestamp  string                 `protobuf:"bytes,6,opt,name=timestamp,proto3" json:"timestamp,omitempty"`
+	// Empty if the message was never edited
+	EditedAt string `protobuf:"bytes,7,opt,name=edited_at,json=editedAt,proto3" json:"edited_at,omitempty"`
+	// Deleted messages keep their ID and sender but have no content
+	Deleted bool `protobuf:"varint,8,opt,name=deleted,proto3" json:"deleted,omitempty"`
+	// Only set on streams: "message" for a new message, or "edit" or "delete"
+	// for a change to the earlier message with the same ID. "resync", on an
+	// otherwise empty message, follows a catch up after the client fell behind:
+	// edits and deletions may have been missed, and messages should be reloaded.
+	// "room_deleted", on an otherwise empty message, is sent last when the room
+	// is deleted.
+	Event string `protobuf:"bytes,9,opt,name=event,proto3" json:"event,omitempty"`
+	// Opaque position of the message, to resume a stream after it
+	Cursor string `protobuf:"bytes,10,opt,name=cursor,proto3" json:"cursor,omitempty"`
+	// Only set by GetRoomMessages, in the order each emoji was first used
+	Reactions []*ReactionCount `protobuf:"bytes,11,rep,name=reactions,proto3" json:"reactions,omitempty"`
+	// For replies, the first message of the thread
+	ParentMessageId int64 `protobuf:"varint,12,opt,name=parent_message_id,json=parentMessageId,proto3" json:"parent_message_id,omitempty"`
+	// Number of replies and time of the latest one. last_reply_at is empty
+	// if the message has no replies.
+	ReplyCount  int64  `protobuf:"varint,13,opt,name=reply_count,json=replyCount,proto3" json:"reply_count,omitempty"`
+	LastReplyAt string `protobuf:"bytes,14,opt,name=last_reply_at,json=lastReplyAt,proto3" json:"last_reply_at,omitempty"`
+	// For inline quote replies, the message quoted
+	ReplyToId int64 `protobuf:"varint,15,opt,name=reply_to_id,json=replyToId,proto3" json:"reply_to_id,omitempty"`
+	// For forwarded messages, where the message was first posted
+	ForwardedFromRoomId     int64  `protobuf:"varint,16,opt,name=forwarded_from_room_id,json=forwardedFromRoomId,proto3" json:"forwarded_from_room_id,omitempty"`
+	ForwardedFromSenderId   int64  `protobuf:"varint,17,opt,name=forwarded_from_sender_id,json=forwardedFromSenderId,proto3" json:"forwarded_from_sender_id,omitempty"`
+	ForwardedFromSenderName string `protobuf:"bytes,18,opt,name=forwarded_from_sender_name,json=forwardedFromSenderName,proto3" json:"forwarded_from_sender_name,omitempty"`
+	unknownFields           protoimpl.UnknownFields
+	sizeCache               protoimpl.SizeCache
 }
 
 func (x *MessageResponse) Reset() {
@@ -396,83 +487,2113 @@ func (x *MessageResponse) GetTimestamp() string {
 	return ""
 }
 
-var File_proto_chat_chat_proto protoreflect.FileDescriptor
+func (x *MessageResponse) GetEditedAt() string {
+	if x != nil {
+		return x.EditedAt
+	}
+	return ""
+}
 
-const file_proto_chat_chat_proto_rawDesc = "" +
-	"\n" +
-	"\x15proto/chat/chat.proto\x12\x04chat\x1a\x1cgoogle/api/annotations.proto\"d\n" +
-	"\x12SendMessageRequest\x12\x18\n" +
-	"\acontent\x18\x01 \x01(\tR\acontent\x12\x1b\n" +
-	"\tsender_id\x18\x02 \x01(\x03R\bsenderId\x12\x17\n" +
-	"\aroom_id\x18\x03 \x01(\x03R\x06roomId\"h\n" +
-	"\x13SendMessageResponse\x12\x18\n" +
-	"\asuccess\x18\x01 \x01(\bR\asuccess\x12\x18\n" +
-	"\amessage\x18\x02 \x01(\tR\amessage\x12\x1d\n" +
-	"\n" +
-	"message_id\x18\x03 \x01(\x03R\tmessageId\"x\n" +
-	"\x16GetRoomMessagesRequest\x12\x17\n" +
-	"\aroom_id\x18\x01 \x01(\x03R\x06roomId\x12\x17\n" +
-	"\auser_id\x18\x02 \x01(\x03R\x06userId\x12\x14\n" +
-	"\x05limit\x18\x03 \x01(\x03R\x05limit\x12\x16\n" +
-	"\x06offset\x18\x04 \x01(\x03R\x06offset\"L\n" +
-	"\x17GetRoomMessagesResponse\x121\n" +
-	"\bmessages\x18\x01 \x03(\v2\x15.chat.MessageResponseR\bmessages\"M\n" +
-	"\x19StreamRoomMessagesRequest\x12\x17\n" +
-	"\aroom_id\x18\x01 \x01(\x03R\x06roomId\x12\x17\n" +
-	"\auser_id\x18\x02 \x01(\x03R\x06userId\"\xb0\x01\n" +
-	"\x0fMessageResponse\x12\x0e\n" +
-	"\x02id\x18\x01 \x01(\x03R\x02id\x12\x18\n" +
-	"\acontent\x18\x02 \x01(\tR\acontent\x12\x1b\n" +
-	"\tsender_id\x18\x03 \x01(\x03R\bsenderId\x12\x17\n" +
-	"\aroom_id\x18\x04 \x01(\x03R\x06roomId\x12\x1f\n" +
-	"\vsender_name\x18\x05 \x01(\tR\n" +
-	"senderName\x12\x1c\n" +
-	"\ttimestamp\x18\x06 \x01(\tR\ttimestamp2\xd6\x02\n" +
-	"\vChatService\x12a\n" +
-	"\vSendMessage\x12\x18.chat.SendMessageRequest\x1a\x19.chat.SendMessageResponse\"\x1d\x82\xd3\xe4\x93\x02\x17:\x01*\"\x12/chat/send-message\x12r\n" +
-	"\x0fGetRoomMessages\x12\x1c.chat.GetRoomMessagesRequest\x1a\x1d.chat.GetRoomMessagesResponse\"\"\x82\xd3\xe4\x93\x02\x1c:\x01*\"\x17/chat/get-room-messages\x12p\n" +
-	"\x12StreamRoomMessages\x12\x1f.chat.StreamRoomMessagesRequest\x1a\x15.chat.MessageResponse\" \x82\xd3\xe4\x93\x02\x1a:\x01*\"\x15/chat/stream-messages0\x01B Z\x1egrpc-messenger-core/proto/chatb\x06proto3"
+func (x *MessageResponse) GetDeleted() bool {
+	if x != nil {
+		return x.Deleted
+	}
+	return false
+}
 
-var (
-	file_proto_chat_chat_proto_rawDescOnce sync.Once
-	file_proto_chat_chat_proto_rawDescData []byte
-)
+func (x *MessageResponse) GetEvent() string {
+	if x != nil {
+		return x.Event
+	}
+	return ""
+}
 
-func file_proto_chat_chat_proto_rawDescGZIP() []byte {
-	file_proto_chat_chat_proto_rawDescOnce.Do(func() {
-		file_proto_chat_chat_proto_rawDescData = protoimpl.X.CompressGZIP(unsafe.Slice(unsafe.StringData(file_proto_chat_chat_proto_rawDesc), len(file_proto_chat_chat_proto_rawDesc)))
-	})
-	return file_proto_chat_chat_proto_rawDescData
+func (x *MessageResponse) GetCursor() string {
+	if x != nil {
+		return x.Cursor
+	}
+	return ""
 }
 
-var file_proto_chat_chat_proto_msgTypes = make([]protoimpl.MessageInfo, 6)
-var file_proto_chat_chat_proto_goTypes = []any{
-	(*SendMessageRequest)(nil),        // 0: chat.SendMessageRequest
-	(*SendMessageResponse)(nil),       // 1: chat.SendMessageResponse
-	(*GetRoomMessagesRequest)(nil),    // 2: chat.GetRoomMessagesRequest
-	(*GetRoomMessagesResponse)(nil),   // 3: chat.GetRoomMessagesResponse
-	(*StreamRoomMessagesRequest)(nil), // 4: chat.StreamRoomMessagesRequest
-	(*MessageResponse)(nil),           // 5: chat.MessageResponse
+func (x *MessageResponse) GetReactions() []*ReactionCount {
+	if x != nil {
+		return x.Reactions
+	}
+	return nil
 }
-var file_proto_chat_chat_proto_depIdxs = []int32{
-	5, // 0: chat.GetRoomMessagesResponse.messages:type_name -> chat.MessageResponse
-	0, // 1: chat.ChatService.SendMessage:input_type -> chat.SendMessageRequest
-	2, // 2: chat.ChatService.GetRoomMessages:input_type -> chat.GetRoomMessagesRequest
-	4, // 3: chat.ChatService.StreamRoomMessages:input_type -> chat.StreamRoomMessagesRequest
-	1, // 4: chat.ChatService.SendMessage:output_type -> chat.SendMessageResponse
-	3, // 5: chat.ChatService.GetRoomMessages:output_type -> chat.GetRoomMessagesResponse
-	5, // 6: chat.ChatService.StreamRoomMessages:output_type -> chat.MessageResponse
-	4, // [4:7] is the sub-list for method output_type
-	1, // [1:4] is the sub-list for method input_type
-	1, // [1:1] is the sub-list for extension type_name
-	1, // [1:1] is the sub-list for extension extendee
-	0, // [0:1] is the sub-list for field type_name
+
+func (x *MessageResponse) GetParentMessageId() int64 {
+	if x != nil {
+		return x.ParentMessageId
+	}
+	return 0
 }
 
-func init() { file_proto_chat_chat_proto_init() }
-func file_proto_chat_chat_proto_init() {
-	if File_proto_chat_chat_proto != nil {
-		return
+func (x *MessageResponse) GetReplyCount() int64 {
+	if x != nil {
+		return x.ReplyCount
+	}
+	return 0
+}
+
+func (x *MessageResponse) GetLastReplyAt() string {
+	if x != nil {
+		return x.LastReplyAt
+	}
+	return ""
+}
+
+func (x *MessageResponse) GetReplyToId() int64 {
+	if x != nil {
+		return x.ReplyToId
+	}
+	return 0
+}
+
+func (x *MessageResponse) GetForwardedFromRoomId() int64 {
+	if x != nil {
+		return x.ForwardedFromRoomId
+	}
+	return 0
+}
+
+func (x *MessageResponse) GetForwardedFromSenderId() int64 {
+	if x != nil {
+		return x.ForwardedFromSenderId
+	}
+	return 0
+}
+
+func (x *MessageResponse) GetForwardedFromSenderName() string {
+	if x != nil {
+		return x.ForwardedFromSenderName
+	}
+	return ""
+}
+
+// The reactions to a message with one emoji
+type ReactionCount struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	Emoji string                 `protobuf:"bytes,1,opt,name=emoji,proto3" json:"emoji,omitempty"`
+	Count int64                  `protobuf:"varint,2,opt,name=count,proto3" json:"count,omitempty"`
+	// Whether the authenticated user is among those who reacted
+	ReactedByMe   bool `protobuf:"varint,3,opt,name=reacted_by_me,json=reactedByMe,proto3" json:"reacted_by_me,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ReactionCount) Reset() {
+	*x = ReactionCount{}
+	mi := &file_proto_chat_chat_proto_msgTypes[6]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ReactionCount) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ReactionCount) ProtoMessage() {}
+
+func (x *ReactionCount) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_chat_chat_proto_msgTypes[6]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ReactionCount.ProtoReflect.Descriptor instead.
+func (*ReactionCount) Descriptor() ([]byte, []int) {
+	return file_proto_chat_chat_proto_rawDescGZIP(), []int{6}
+}
+
+func (x *ReactionCount) GetEmoji() string {
+	if x != nil {
+		return x.Emoji
+	}
+	return ""
+}
+
+func (x *ReactionCount) GetCount() int64 {
+	if x != nil {
+		return x.Count
+	}
+	return 0
+}
+
+func (x *ReactionCount) GetReactedByMe() bool {
+	if x != nil {
+		return x.ReactedByMe
+	}
+	return false
+}
+
+// Request to edit a message
+type EditMessageRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	MessageId     int64                  `protobuf:"varint,1,opt,name=message_id,json=messageId,proto3" json:"message_id,omitempty"`
+	Content       string                 `protobuf:"bytes,2,opt,name=content,proto3" json:"content,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *EditMessageRequest) Reset() {
+	*x = EditMessageRequest{}
+	mi := &file_proto_chat_chat_proto_msgTypes[7]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *EditMessageRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*EditMessageRequest) ProtoMessage() {}
+
+func (x *EditMessageRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_chat_chat_proto_msgTypes[7]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use EditMessageRequest.ProtoReflect.Descriptor instead.
+func (*EditMessageRequest) Descriptor() ([]byte, []int) {
+	return file_proto_chat_chat_proto_rawDescGZIP(), []int{7}
+}
+
+func (x *EditMessageRequest) GetMessageId() int64 {
+	if x != nil {
+		return x.MessageId
+	}
+	return 0
+}
+
+func (x *EditMessageRequest) GetContent() string {
+	if x != nil {
+		return x.Content
+	}
+	return ""
+}
+
+// Response to an edit message request
+type EditMessageResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Success       bool                   `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
+	Message       string                 `protobuf:"bytes,2,opt,name=message,proto3" json:"message,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *EditMessageResponse) Reset() {
+	*x = EditMessageResponse{}
+	mi := &file_proto_chat_chat_proto_msgTypes[8]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *EditMessageResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*EditMessageResponse) ProtoMessage() {}
+
+func (x *EditMessageResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_chat_chat_proto_msgTypes[8]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use EditMessageResponse.ProtoReflect.Descriptor instead.
+func (*EditMessageResponse) Descriptor() ([]byte, []int) {
+	return file_proto_chat_chat_proto_rawDescGZIP(), []int{8}
+}
+
+func (x *EditMessageResponse) GetSuccess() bool {
+	if x != nil {
+		return x.Success
+	}
+	return false
+}
+
+func (x *EditMessageResponse) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+// Request to delete a message
+type DeleteMessageRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	MessageId     int64                  `protobuf:"varint,1,opt,name=message_id,json=messageId,proto3" json:"message_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeleteMessageRequest) Reset() {
+	*x = DeleteMessageRequest{}
+	mi := &file_proto_chat_chat_proto_msgTypes[9]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteMessageRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteMessageRequest) ProtoMessage() {}
+
+func (x *DeleteMessageRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_chat_chat_proto_msgTypes[9]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteMessageRequest.ProtoReflect.Descriptor instead.
+func (*DeleteMessageRequest) Descriptor() ([]byte, []int) {
+	return file_proto_chat_chat_proto_rawDescGZIP(), []int{9}
+}
+
+func (x *DeleteMessageRequest) GetMessageId() int64 {
+	if x != nil {
+		return x.MessageId
+	}
+	return 0
+}
+
+// Response to a delete message request
+type DeleteMessageResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Success       bool                   `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
+	Message       string                 `protobuf:"bytes,2,opt,name=message,proto3" json:"message,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeleteMessageResponse) Reset() {
+	*x = DeleteMessageResponse{}
+	mi := &file_proto_chat_chat_proto_msgTypes[10]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteMessageResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteMessageResponse) ProtoMessage() {}
+
+func (x *DeleteMessageResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_chat_chat_proto_msgTypes[10]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteMessageResponse.ProtoReflect.Descriptor instead.
+func (*DeleteMessageResponse) Descriptor() ([]byte, []int) {
+	return file_proto_chat_chat_proto_rawDescGZIP(), []int{10}
+}
+
+func (x *DeleteMessageResponse) GetSuccess() bool {
+	if x != nil {
+		return x.Success
+	}
+	return false
+}
+
+func (x *DeleteMessageResponse) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+// Request to forward a message
+type ForwardMessageRequest struct {
+	state     protoimpl.MessageState `protogen:"open.v1"`
+	MessageId int64                  `protobuf:"varint,1,opt,name=message_id,json=messageId,proto3" json:"message_id,omitempty"`
+	// The room to post the copy in
+	RoomId        int64 `protobuf:"varint,2,opt,name=room_id,json=roomId,proto3" json:"room_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ForwardMessageRequest) Reset() {
+	*x = ForwardMessageRequest{}
+	mi := &file_proto_chat_chat_proto_msgTypes[11]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ForwardMessageRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ForwardMessageRequest) ProtoMessage() {}
+
+func (x *ForwardMessageRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_chat_chat_proto_msgTypes[11]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ForwardMessageRequest.ProtoReflect.Descriptor instead.
+func (*ForwardMessageRequest) Descriptor() ([]byte, []int) {
+	return file_proto_chat_chat_proto_rawDescGZIP(), []int{11}
+}
+
+func (x *ForwardMessageRequest) GetMessageId() int64 {
+	if x != nil {
+		return x.MessageId
+	}
+	return 0
+}
+
+func (x *ForwardMessageRequest) GetRoomId() int64 {
+	if x != nil {
+		return x.RoomId
+	}
+	return 0
+}
+
+// Response to a forward message request
+type ForwardMessageResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Success       bool                   `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
+	Message       string                 `protobuf:"bytes,2,opt,name=message,proto3" json:"message,omitempty"`
+	MessageId     int64                  `protobuf:"varint,3,opt,name=message_id,json=messageId,proto3" json:"message_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ForwardMessageResponse) Reset() {
+	*x = ForwardMessageResponse{}
+	mi := &file_proto_chat_chat_proto_msgTypes[12]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ForwardMessageResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ForwardMessageResponse) ProtoMessage() {}
+
+func (x *ForwardMessageResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_chat_chat_proto_msgTypes[12]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ForwardMessageResponse.ProtoReflect.Descriptor instead.
+func (*ForwardMessageResponse) Descriptor() ([]byte, []int) {
+	return file_proto_chat_chat_proto_rawDescGZIP(), []int{12}
+}
+
+func (x *ForwardMessageResponse) GetSuccess() bool {
+	if x != nil {
+		return x.Success
+	}
+	return false
+}
+
+func (x *ForwardMessageResponse) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+func (x *ForwardMessageResponse) GetMessageId() int64 {
+	if x != nil {
+		return x.MessageId
+	}
+	return 0
+}
+
+// Request to get a thread
+type GetThreadRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// The first message of the thread or any reply in it
+	MessageId     int64 `protobuf:"varint,1,opt,name=message_id,json=messageId,proto3" json:"message_id,omitempty"`
+	Limit         int64 `protobuf:"varint,2,opt,name=limit,proto3" json:"limit,omitempty"`
+	Offset        int64 `protobuf:"varint,3,opt,name=offset,proto3" json:"offset,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetThreadRequest) Reset() {
+	*x = GetThreadRequest{}
+	mi := &file_proto_chat_chat_proto_msgTypes[13]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetThreadRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetThreadRequest) ProtoMessage() {}
+
+func (x *GetThreadRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_chat_chat_proto_msgTypes[13]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetThreadRequest.ProtoReflect.Descriptor instead.
+func (*GetThreadRequest) Descriptor() ([]byte, []int) {
+	return file_proto_chat_chat_proto_rawDescGZIP(), []int{13}
+}
+
+func (x *GetThreadRequest) GetMessageId() int64 {
+	if x != nil {
+		return x.MessageId
+	}
+	return 0
+}
+
+func (x *GetThreadRequest) GetLimit() int64 {
+	if x != nil {
+		return x.Limit
+	}
+	return 0
+}
+
+func (x *GetThreadRequest) GetOffset() int64 {
+	if x != nil {
+		return x.Offset
+	}
+	return 0
+}
+
+// Response to a get thread request
+type GetThreadResponse struct {
+	state   protoimpl.MessageState `protogen:"open.v1"`
+	Success bool                   `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
+	Message string                 `protobuf:"bytes,2,opt,name=message,proto3" json:"message,omitempty"`
+	Parent  *MessageResponse       `protobuf:"bytes,3,opt,name=parent,proto3" json:"parent,omitempty"`
+	// Oldest first
+	Replies       []*MessageResponse `protobuf:"bytes,4,rep,name=replies,proto3" json:"replies,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetThreadResponse) Reset() {
+	*x = GetThreadResponse{}
+	mi := &file_proto_chat_chat_proto_msgTypes[14]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetThreadResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetThreadResponse) ProtoMessage() {}
+
+func (x *GetThreadResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_chat_chat_proto_msgTypes[14]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetThreadResponse.ProtoReflect.Descriptor instead.
+func (*GetThreadResponse) Descriptor() ([]byte, []int) {
+	return file_proto_chat_chat_proto_rawDescGZIP(), []int{14}
+}
+
+func (x *GetThreadResponse) GetSuccess() bool {
+	if x != nil {
+		return x.Success
+	}
+	return false
+}
+
+func (x *GetThreadResponse) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+func (x *GetThreadResponse) GetParent() *MessageResponse {
+	if x != nil {
+		return x.Parent
+	}
+	return nil
+}
+
+func (x *GetThreadResponse) GetReplies() []*MessageResponse {
+	if x != nil {
+		return x.Replies
+	}
+	return nil
+}
+
+// Request to add a reaction to a message
+type AddReactionRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	MessageId     int64                  `protobuf:"varint,1,opt,name=message_id,json=messageId,proto3" json:"message_id,omitempty"`
+	Emoji         string                 `protobuf:"bytes,2,opt,name=emoji,proto3" json:"emoji,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *AddReactionRequest) Reset() {
+	*x = AddReactionRequest{}
+	mi := &file_proto_chat_chat_proto_msgTypes[15]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AddReactionRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AddReactionRequest) ProtoMessage() {}
+
+func (x *AddReactionRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_chat_chat_proto_msgTypes[15]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AddReactionRequest.ProtoReflect.Descriptor instead.
+func (*AddReactionRequest) Descriptor() ([]byte, []int) {
+	return file_proto_chat_chat_proto_rawDescGZIP(), []int{15}
+}
+
+func (x *AddReactionRequest) GetMessageId() int64 {
+	if x != nil {
+		return x.MessageId
+	}
+	return 0
+}
+
+func (x *AddReactionRequest) GetEmoji() string {
+	if x != nil {
+		return x.Emoji
+	}
+	return ""
+}
+
+// Response to an add reaction request
+type AddReactionResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Success       bool                   `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
+	Message       string                 `protobuf:"bytes,2,opt,name=message,proto3" json:"message,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *AddReactionResponse) Reset() {
+	*x = AddReactionResponse{}
+	mi := &file_proto_chat_chat_proto_msgTypes[16]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AddReactionResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AddReactionResponse) ProtoMessage() {}
+
+func (x *AddReactionResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_chat_chat_proto_msgTypes[16]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AddReactionResponse.ProtoReflect.Descriptor instead.
+func (*AddReactionResponse) Descriptor() ([]byte, []int) {
+	return file_proto_chat_chat_proto_rawDescGZIP(), []int{16}
+}
+
+func (x *AddReactionResponse) GetSuccess() bool {
+	if x != nil {
+		return x.Success
+	}
+	return false
+}
+
+func (x *AddReactionResponse) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+// Request to remove a reaction from a message
+type RemoveReactionRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	MessageId     int64                  `protobuf:"varint,1,opt,name=message_id,json=messageId,proto3" json:"message_id,omitempty"`
+	Emoji         string                 `protobuf:"bytes,2,opt,name=emoji,proto3" json:"emoji,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RemoveReactionRequest) Reset() {
+	*x = RemoveReactionRequest{}
+	mi := &file_proto_chat_chat_proto_msgTypes[17]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RemoveReactionRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RemoveReactionRequest) ProtoMessage() {}
+
+func (x *RemoveReactionRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_chat_chat_proto_msgTypes[17]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RemoveReactionRequest.ProtoReflect.Descriptor instead.
+func (*RemoveReactionRequest) Descriptor() ([]byte, []int) {
+	return file_proto_chat_chat_proto_rawDescGZIP(), []int{17}
+}
+
+func (x *RemoveReactionRequest) GetMessageId() int64 {
+	if x != nil {
+		return x.MessageId
+	}
+	return 0
+}
+
+func (x *RemoveReactionRequest) GetEmoji() string {
+	if x != nil {
+		return x.Emoji
+	}
+	return ""
+}
+
+// Response to a remove reaction request
+type RemoveReactionResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Success       bool                   `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
+	Message       string                 `protobuf:"bytes,2,opt,name=message,proto3" json:"message,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RemoveReactionResponse) Reset() {
+	*x = RemoveReactionResponse{}
+	mi := &file_proto_chat_chat_proto_msgTypes[18]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RemoveReactionResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RemoveReactionResponse) ProtoMessage() {}
+
+func (x *RemoveReactionResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_chat_chat_proto_msgTypes[18]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RemoveReactionResponse.ProtoReflect.Descriptor instead.
+func (*RemoveReactionResponse) Descriptor() ([]byte, []int) {
+	return file_proto_chat_chat_proto_rawDescGZIP(), []int{18}
+}
+
+func (x *RemoveReactionResponse) GetSuccess() bool {
+	if x != nil {
+		return x.Success
+	}
+	return false
+}
+
+func (x *RemoveReactionResponse) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+// Request to stream the events of a room
+type StreamRoomEventsRequest struct {
+	state  protoimpl.MessageState `protogen:"open.v1"`
+	RoomId int64                  `protobuf:"varint,1,opt,name=room_id,json=roomId,proto3" json:"room_id,omitempty"`
+	// To resume a stream, set one of these to the last message received.
+	// Messages sent after it are replayed before live delivery starts.
+	SinceMessageId int64  `protobuf:"varint,2,opt,name=since_message_id,json=sinceMessageId,proto3" json:"since_message_id,omitempty"`
+	Cursor         string `protobuf:"bytes,3,opt,name=cursor,proto3" json:"cursor,omitempty"`
+	// To follow a single thread, the ID of its first message. Only events on
+	// that message and its replies are streamed, along with the events not
+	// about a message. Otherwise thread replies are left out, as in
+	// GetRoomMessages.
+	ThreadId      int64 `protobuf:"varint,4,opt,name=thread_id,json=threadId,proto3" json:"thread_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *StreamRoomEventsRequest) Reset() {
+	*x = StreamRoomEventsRequest{}
+	mi := &file_proto_chat_chat_proto_msgTypes[19]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *StreamRoomEventsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*StreamRoomEventsRequest) ProtoMessage() {}
+
+func (x *StreamRoomEventsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_chat_chat_proto_msgTypes[19]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use StreamRoomEventsRequest.ProtoReflect.Descriptor instead.
+func (*StreamRoomEventsRequest) Descriptor() ([]byte, []int) {
+	return file_proto_chat_chat_proto_rawDescGZIP(), []int{19}
+}
+
+func (x *StreamRoomEventsRequest) GetRoomId() int64 {
+	if x != nil {
+		return x.RoomId
+	}
+	return 0
+}
+
+func (x *StreamRoomEventsRequest) GetSinceMessageId() int64 {
+	if x != nil {
+		return x.SinceMessageId
+	}
+	return 0
+}
+
+func (x *StreamRoomEventsRequest) GetCursor() string {
+	if x != nil {
+		return x.Cursor
+	}
+	return ""
+}
+
+func (x *StreamRoomEventsRequest) GetThreadId() int64 {
+	if x != nil {
+		return x.ThreadId
+	}
+	return 0
+}
+
+// Event in a room
+type RoomEvent struct {
+	state     protoimpl.MessageState `protogen:"open.v1"`
+	RoomId    int64                  `protobuf:"varint,1,opt,name=room_id,json=roomId,proto3" json:"room_id,omitempty"`
+	Timestamp string                 `protobuf:"bytes,2,opt,name=timestamp,proto3" json:"timestamp,omitempty"`
+	// Types that are valid to be assigned to Event:
+	//
+	//	*RoomEvent_Message
+	//	*RoomEvent_MessageEdited
+	//	*RoomEvent_MessageDeleted
+	//	*RoomEvent_Reaction
+	//	*RoomEvent_Typing
+	//	*RoomEvent_Presence
+	//	*RoomEvent_MemberJoined
+	//	*RoomEvent_MemberLeft
+	//	*RoomEvent_RoomUpdated
+	//	*RoomEvent_RoomDeleted
+	//	*RoomEvent_Resync
+	Event         isRoomEvent_Event `protobuf_oneof:"event"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RoomEvent) Reset() {
+	*x = RoomEvent{}
+	mi := &file_proto_chat_chat_proto_msgTypes[20]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RoomEvent) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RoomEvent) ProtoMessage() {}
+
+func (x *RoomEvent) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_chat_chat_proto_msgTypes[20]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RoomEvent.ProtoReflect.Descriptor instead.
+func (*RoomEvent) Descriptor() ([]byte, []int) {
+	return file_proto_chat_chat_proto_rawDescGZIP(), []int{20}
+}
+
+func (x *RoomEvent) GetRoomId() int64 {
+	if x != nil {
+		return x.RoomId
+	}
+	return 0
+}
+
+func (x *RoomEvent) GetTimestamp() string {
+	if x != nil {
+		return x.Timestamp
+	}
+	return ""
+}
+
+func (x *RoomEvent) GetEvent() isRoomEvent_Event {
+	if x != nil {
+		return x.Event
+	}
+	return nil
+}
+
+func (x *RoomEvent) GetMessage() *MessageResponse {
+	if x != nil {
+		if x, ok := x.Event.(*RoomEvent_Message); ok {
+			return x.Message
+		}
+	}
+	return nil
+}
+
+func (x *RoomEvent) GetMessageEdited() *MessageResponse {
+	if x != nil {
+		if x, ok := x.Event.(*RoomEvent_MessageEdited); ok {
+			return x.MessageEdited
+		}
+	}
+	return nil
+}
+
+func (x *RoomEvent) GetMessageDeleted() *MessageDeleted {
+	if x != nil {
+		if x, ok := x.Event.(*RoomEvent_MessageDeleted); ok {
+			return x.MessageDeleted
+		}
+	}
+	return nil
+}
+
+func (x *RoomEvent) GetReaction() *ReactionEvent {
+	if x != nil {
+		if x, ok := x.Event.(*RoomEvent_Reaction); ok {
+			return x.Reaction
+		}
+	}
+	return nil
+}
+
+func (x *RoomEvent) GetTyping() *TypingEvent {
+	if x != nil {
+		if x, ok := x.Event.(*RoomEvent_Typing); ok {
+			return x.Typing
+		}
+	}
+	return nil
+}
+
+func (x *RoomEvent) GetPresence() *PresenceEvent {
+	if x != nil {
+		if x, ok := x.Event.(*RoomEvent_Presence); ok {
+			return x.Presence
+		}
+	}
+	return nil
+}
+
+func (x *RoomEvent) GetMemberJoined() *MemberEvent {
+	if x != nil {
+		if x, ok := x.Event.(*RoomEvent_MemberJoined); ok {
+			return x.MemberJoined
+		}
+	}
+	return nil
+}
+
+func (x *RoomEvent) GetMemberLeft() *MemberEvent {
+	if x != nil {
+		if x, ok := x.Event.(*RoomEvent_MemberLeft); ok {
+			return x.MemberLeft
+		}
+	}
+	return nil
+}
+
+func (x *RoomEvent) GetRoomUpdated() *RoomUpdatedEvent {
+	if x != nil {
+		if x, ok := x.Event.(*RoomEvent_RoomUpdated); ok {
+			return x.RoomUpdated
+		}
+	}
+	return nil
+}
+
+func (x *RoomEvent) GetRoomDeleted() *RoomDeletedEvent {
+	if x != nil {
+		if x, ok := x.Event.(*RoomEvent_RoomDeleted); ok {
+			return x.RoomDeleted
+		}
+	}
+	return nil
+}
+
+func (x *RoomEvent) GetResync() *ResyncEvent {
+	if x != nil {
+		if x, ok := x.Event.(*RoomEvent_Resync); ok {
+			return x.Resync
+		}
+	}
+	return nil
+}
+
+type isRoomEvent_Event interface {
+	isRoomEvent_Event()
+}
+
+type RoomEvent_Message struct {
+	Message *MessageResponse `protobuf:"bytes,3,opt,name=message,proto3,oneof"`
+}
+
+type RoomEvent_MessageEdited struct {
+	// The message as it is after the edit
+	MessageEdited *MessageResponse `protobuf:"bytes,4,opt,name=message_edited,json=messageEdited,proto3,oneof"`
+}
+
+type RoomEvent_MessageDeleted struct {
+	MessageDeleted *MessageDeleted `protobuf:"bytes,5,opt,name=message_deleted,json=messageDeleted,proto3,oneof"`
+}
+
+type RoomEvent_Reaction struct {
+	Reaction *ReactionEvent `protobuf:"bytes,6,opt,name=reaction,proto3,oneof"`
+}
+
+type RoomEvent_Typing struct {
+	Typing *TypingEvent `protobuf:"bytes,7,opt,name=typing,proto3,oneof"`
+}
+
+type RoomEvent_Presence struct {
+	Presence *PresenceEvent `protobuf:"bytes,8,opt,name=presence,proto3,oneof"`
+}
+
+type RoomEvent_MemberJoined struct {
+	MemberJoined *MemberEvent `protobuf:"bytes,9,opt,name=member_joined,json=memberJoined,proto3,oneof"`
+}
+
+type RoomEvent_MemberLeft struct {
+	MemberLeft *MemberEvent `protobuf:"bytes,10,opt,name=member_left,json=memberLeft,proto3,oneof"`
+}
+
+type RoomEvent_RoomUpdated struct {
+	RoomUpdated *RoomUpdatedEvent `protobuf:"bytes,11,opt,name=room_updated,json=roomUpdated,proto3,oneof"`
+}
+
+type RoomEvent_RoomDeleted struct {
+	// Sent last, before the stream ends
+	RoomDeleted *RoomDeletedEvent `protobuf:"bytes,12,opt,name=room_deleted,json=roomDeleted,proto3,oneof"`
+}
+
+type RoomEvent_Resync struct {
+	Resync *ResyncEvent `protobuf:"bytes,13,opt,name=resync,proto3,oneof"`
+}
+
+func (*RoomEvent_Message) isRoomEvent_Event() {}
+
+func (*RoomEvent_MessageEdited) isRoomEvent_Event() {}
+
+func (*RoomEvent_MessageDeleted) isRoomEvent_Event() {}
+
+func (*RoomEvent_Reaction) isRoomEvent_Event() {}
+
+func (*RoomEvent_Typing) isRoomEvent_Event() {}
+
+func (*RoomEvent_Presence) isRoomEvent_Event() {}
+
+func (*RoomEvent_MemberJoined) isRoomEvent_Event() {}
+
+func (*RoomEvent_MemberLeft) isRoomEvent_Event() {}
+
+func (*RoomEvent_RoomUpdated) isRoomEvent_Event() {}
+
+func (*RoomEvent_RoomDeleted) isRoomEvent_Event() {}
+
+func (*RoomEvent_Resync) isRoomEvent_Event() {}
+
+// A message was deleted
+type MessageDeleted struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	MessageId     int64                  `protobuf:"varint,1,opt,name=message_id,json=messageId,proto3" json:"message_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *MessageDeleted) Reset() {
+	*x = MessageDeleted{}
+	mi := &file_proto_chat_chat_proto_msgTypes[21]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *MessageDeleted) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MessageDeleted) ProtoMessage() {}
+
+func (x *MessageDeleted) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_chat_chat_proto_msgTypes[21]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MessageDeleted.ProtoReflect.Descriptor instead.
+func (*MessageDeleted) Descriptor() ([]byte, []int) {
+	return file_proto_chat_chat_proto_rawDescGZIP(), []int{21}
+}
+
+func (x *MessageDeleted) GetMessageId() int64 {
+	if x != nil {
+		return x.MessageId
+	}
+	return 0
+}
+
+// A reaction was added to or removed from a message
+type ReactionEvent struct {
+	state     protoimpl.MessageState `protogen:"open.v1"`
+	MessageId int64                  `protobuf:"varint,1,opt,name=message_id,json=messageId,proto3" json:"message_id,omitempty"`
+	UserId    int64                  `protobuf:"varint,2,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	Emoji     string                 `protobuf:"bytes,3,opt,name=emoji,proto3" json:"emoji,omitempty"`
+	// False if the reaction was removed
+	Added         bool   `protobuf:"varint,4,opt,name=added,proto3" json:"added,omitempty"`
+	Username      string `protobuf:"bytes,5,opt,name=username,proto3" json:"username,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ReactionEvent) Reset() {
+	*x = ReactionEvent{}
+	mi := &file_proto_chat_chat_proto_msgTypes[22]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ReactionEvent) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ReactionEvent) ProtoMessage() {}
+
+func (x *ReactionEvent) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_chat_chat_proto_msgTypes[22]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ReactionEvent.ProtoReflect.Descriptor instead.
+func (*ReactionEvent) Descriptor() ([]byte, []int) {
+	return file_proto_chat_chat_proto_rawDescGZIP(), []int{22}
+}
+
+func (x *ReactionEvent) GetMessageId() int64 {
+	if x != nil {
+		return x.MessageId
+	}
+	return 0
+}
+
+func (x *ReactionEvent) GetUserId() int64 {
+	if x != nil {
+		return x.UserId
+	}
+	return 0
+}
+
+func (x *ReactionEvent) GetEmoji() string {
+	if x != nil {
+		return x.Emoji
+	}
+	return ""
+}
+
+func (x *ReactionEvent) GetAdded() bool {
+	if x != nil {
+		return x.Added
+	}
+	return false
+}
+
+func (x *ReactionEvent) GetUsername() string {
+	if x != nil {
+		return x.Username
+	}
+	return ""
+}
+
+// A user started or stopped typing
+type TypingEvent struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	UserId        int64                  `protobuf:"varint,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	Username      string                 `protobuf:"bytes,2,opt,name=username,proto3" json:"username,omitempty"`
+	Typing        bool                   `protobuf:"varint,3,opt,name=typing,proto3" json:"typing,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *TypingEvent) Reset() {
+	*x = TypingEvent{}
+	mi := &file_proto_chat_chat_proto_msgTypes[23]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *TypingEvent) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TypingEvent) ProtoMessage() {}
+
+func (x *TypingEvent) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_chat_chat_proto_msgTypes[23]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TypingEvent.ProtoReflect.Descriptor instead.
+func (*TypingEvent) Descriptor() ([]byte, []int) {
+	return file_proto_chat_chat_proto_rawDescGZIP(), []int{23}
+}
+
+func (x *TypingEvent) GetUserId() int64 {
+	if x != nil {
+		return x.UserId
+	}
+	return 0
+}
+
+func (x *TypingEvent) GetUsername() string {
+	if x != nil {
+		return x.Username
+	}
+	return ""
+}
+
+func (x *TypingEvent) GetTyping() bool {
+	if x != nil {
+		return x.Typing
+	}
+	return false
+}
+
+// A user opened their first or closed their last stream on the room, across
+// every chat service instance
+type PresenceEvent struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	UserId        int64                  `protobuf:"varint,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	Username      string                 `protobuf:"bytes,2,opt,name=username,proto3" json:"username,omitempty"`
+	Online        bool                   `protobuf:"varint,3,opt,name=online,proto3" json:"online,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *PresenceEvent) Reset() {
+	*x = PresenceEvent{}
+	mi := &file_proto_chat_chat_proto_msgTypes[24]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PresenceEvent) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PresenceEvent) ProtoMessage() {}
+
+func (x *PresenceEvent) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_chat_chat_proto_msgTypes[24]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PresenceEvent.ProtoReflect.Descriptor instead.
+func (*PresenceEvent) Descriptor() ([]byte, []int) {
+	return file_proto_chat_chat_proto_rawDescGZIP(), []int{24}
+}
+
+func (x *PresenceEvent) GetUserId() int64 {
+	if x != nil {
+		return x.UserId
+	}
+	return 0
+}
+
+func (x *PresenceEvent) GetUsername() string {
+	if x != nil {
+		return x.Username
+	}
+	return ""
+}
+
+func (x *PresenceEvent) GetOnline() bool {
+	if x != nil {
+		return x.Online
+	}
+	return false
+}
+
+// A user joined or left the room
+type MemberEvent struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	UserId        int64                  `protobuf:"varint,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	Username      string                 `protobuf:"bytes,2,opt,name=username,proto3" json:"username,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *MemberEvent) Reset() {
+	*x = MemberEvent{}
+	mi := &file_proto_chat_chat_proto_msgTypes[25]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *MemberEvent) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MemberEvent) ProtoMessage() {}
+
+func (x *MemberEvent) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_chat_chat_proto_msgTypes[25]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MemberEvent.ProtoReflect.Descriptor instead.
+func (*MemberEvent) Descriptor() ([]byte, []int) {
+	return file_proto_chat_chat_proto_rawDescGZIP(), []int{25}
+}
+
+func (x *MemberEvent) GetUserId() int64 {
+	if x != nil {
+		return x.UserId
+	}
+	return 0
+}
+
+func (x *MemberEvent) GetUsername() string {
+	if x != nil {
+		return x.Username
+	}
+	return ""
+}
+
+// The room's details changed
+type RoomUpdatedEvent struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Name          string                 `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	Description   string                 `protobuf:"bytes,2,opt,name=description,proto3" json:"description,omitempty"`
+	Topic         string                 `protobuf:"bytes,3,opt,name=topic,proto3" json:"topic,omitempty"`
+	AvatarUrl     string                 `protobuf:"bytes,4,opt,name=avatar_url,json=avatarUrl,proto3" json:"avatar_url,omitempty"`
+	Archived      bool                   `protobuf:"varint,5,opt,name=archived,proto3" json:"archived,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RoomUpdatedEvent) Reset() {
+	*x = RoomUpdatedEvent{}
+	mi := &file_proto_chat_chat_proto_msgTypes[26]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RoomUpdatedEvent) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RoomUpdatedEvent) ProtoMessage() {}
+
+func (x *RoomUpdatedEvent) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_chat_chat_proto_msgTypes[26]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RoomUpdatedEvent.ProtoReflect.Descriptor instead.
+func (*RoomUpdatedEvent) Descriptor() ([]byte, []int) {
+	return file_proto_chat_chat_proto_rawDescGZIP(), []int{26}
+}
+
+func (x *RoomUpdatedEvent) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *RoomUpdatedEvent) GetDescription() string {
+	if x != nil {
+		return x.Description
+	}
+	return ""
+}
+
+func (x *RoomUpdatedEvent) GetTopic() string {
+	if x != nil {
+		return x.Topic
+	}
+	return ""
+}
+
+func (x *RoomUpdatedEvent) GetAvatarUrl() string {
+	if x != nil {
+		return x.AvatarUrl
+	}
+	return ""
+}
+
+func (x *RoomUpdatedEvent) GetArchived() bool {
+	if x != nil {
+		return x.Archived
+	}
+	return false
+}
+
+// The room was deleted
+type RoomDeletedEvent struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RoomDeletedEvent) Reset() {
+	*x = RoomDeletedEvent{}
+	mi := &file_proto_chat_chat_proto_msgTypes[27]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RoomDeletedEvent) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RoomDeletedEvent) ProtoMessage() {}
+
+func (x *RoomDeletedEvent) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_chat_chat_proto_msgTypes[27]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RoomDeletedEvent.ProtoReflect.Descriptor instead.
+func (*RoomDeletedEvent) Descriptor() ([]byte, []int) {
+	return file_proto_chat_chat_proto_rawDescGZIP(), []int{27}
+}
+
+// The stream fell behind and caught up on the new messages it missed. Other
+// events may have been missed too, so the client should reload the room.
+type ResyncEvent struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ResyncEvent) Reset() {
+	*x = ResyncEvent{}
+	mi := &file_proto_chat_chat_proto_msgTypes[28]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ResyncEvent) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ResyncEvent) ProtoMessage() {}
+
+func (x *ResyncEvent) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_chat_chat_proto_msgTypes[28]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ResyncEvent.ProtoReflect.Descriptor instead.
+func (*ResyncEvent) Descriptor() ([]byte, []int) {
+	return file_proto_chat_chat_proto_rawDescGZIP(), []int{28}
+}
+
+// Request to send a typing indicator
+type SendTypingRequest struct {
+	state  protoimpl.MessageState `protogen:"open.v1"`
+	RoomId int64                  `protobuf:"varint,1,opt,name=room_id,json=roomId,proto3" json:"room_id,omitempty"`
+	// False when the user stopped typing
+	Typing        bool `protobuf:"varint,2,opt,name=typing,proto3" json:"typing,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SendTypingRequest) Reset() {
+	*x = SendTypingRequest{}
+	mi := &file_proto_chat_chat_proto_msgTypes[29]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SendTypingRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SendTypingRequest) ProtoMessage() {}
+
+func (x *SendTypingRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_chat_chat_proto_msgTypes[29]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SendTypingRequest.ProtoReflect.Descriptor instead.
+func (*SendTypingRequest) Descriptor() ([]byte, []int) {
+	return file_proto_chat_chat_proto_rawDescGZIP(), []int{29}
+}
+
+func (x *SendTypingRequest) GetRoomId() int64 {
+	if x != nil {
+		return x.RoomId
+	}
+	return 0
+}
+
+func (x *SendTypingRequest) GetTyping() bool {
+	if x != nil {
+		return x.Typing
+	}
+	return false
+}
+
+// Response to a send typing request
+type SendTypingResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Success       bool                   `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
+	Message       string                 `protobuf:"bytes,2,opt,name=message,proto3" json:"message,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SendTypingResponse) Reset() {
+	*x = SendTypingResponse{}
+	mi := &file_proto_chat_chat_proto_msgTypes[30]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SendTypingResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SendTypingResponse) ProtoMessage() {}
+
+func (x *SendTypingResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_chat_chat_proto_msgTypes[30]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SendTypingResponse.ProtoReflect.Descriptor instead.
+func (*SendTypingResponse) Descriptor() ([]byte, []int) {
+	return file_proto_chat_chat_proto_rawDescGZIP(), []int{30}
+}
+
+func (x *SendTypingResponse) GetSuccess() bool {
+	if x != nil {
+		return x.Success
+	}
+	return false
+}
+
+func (x *SendTypingResponse) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+// Request to get stream statistics
+type GetStreamStatsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetStreamStatsRequest) Reset() {
+	*x = GetStreamStatsRequest{}
+	mi := &file_proto_chat_chat_proto_msgTypes[31]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetStreamStatsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetStreamStatsRequest) ProtoMessage() {}
+
+func (x *GetStreamStatsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_chat_chat_proto_msgTypes[31]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetStreamStatsRequest.ProtoReflect.Descriptor instead.
+func (*GetStreamStatsRequest) Descriptor() ([]byte, []int) {
+	return file_proto_chat_chat_proto_rawDescGZIP(), []int{31}
+}
+
+// Response to get stream statistics
+type GetStreamStatsResponse struct {
+	state   protoimpl.MessageState `protogen:"open.v1"`
+	Success bool                   `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
+	Message string                 `protobuf:"bytes,2,opt,name=message,proto3" json:"message,omitempty"`
+	// Streams currently open on this instance
+	OpenStreams int64 `protobuf:"varint,3,opt,name=open_streams,json=openStreams,proto3" json:"open_streams,omitempty"`
+	// Events not delivered because a stream's queue was full
+	DroppedEvents int64 `protobuf:"varint,4,opt,name=dropped_events,json=droppedEvents,proto3" json:"dropped_events,omitempty"`
+	// Streams whose queue overflowed and were disconnected or caught up from
+	// the database
+	SlowConsumers int64 `protobuf:"varint,5,opt,name=slow_consumers,json=slowConsumers,proto3" json:"slow_consumers,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetStreamStatsResponse) Reset() {
+	*x = GetStreamStatsResponse{}
+	mi := &file_proto_chat_chat_proto_msgTypes[32]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetStreamStatsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetStreamStatsResponse) ProtoMessage() {}
+
+func (x *GetStreamStatsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_chat_chat_proto_msgTypes[32]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetStreamStatsResponse.ProtoReflect.Descriptor instead.
+func (*GetStreamStatsResponse) Descriptor() ([]byte, []int) {
+	return file_proto_chat_chat_proto_rawDescGZIP(), []int{32}
+}
+
+func (x *GetStreamStatsResponse) GetSuccess() bool {
+	if x != nil {
+		return x.Success
+	}
+	return false
+}
+
+func (x *GetStreamStatsResponse) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+func (x *GetStreamStatsResponse) GetOpenStreams() int64 {
+	if x != nil {
+		return x.OpenStreams
+	}
+	return 0
+}
+
+func (x *GetStreamStatsResponse) GetDroppedEvents() int64 {
+	if x != nil {
+		return x.DroppedEvents
+	}
+	return 0
+}
+
+func (x *GetStreamStatsResponse) GetSlowConsumers() int64 {
+	if x != nil {
+		return x.SlowConsumers
+	}
+	return 0
+}
+
+var File_proto_chat_chat_proto protoreflect.FileDescriptor
+
+const file_proto_chat_chat_proto_rawDesc = "" +
+	"\n" +
+	"\x15proto/chat/chat.proto\x12\x04chat\x1a\x1cgoogle/api/annotations.proto\"\xc7\x01\n" +
+	"\x12SendMessageRequest\x12\x18\n" +
+	"\acontent\x18\x01 \x01(\tR\acontent\x12$\n" +
+	"\tsender_id\x18\x02 \x01(\x03B\x02\x18\x01H\x00R\bsenderId\x88\x01\x01\x12\x17\n" +
+	"\aroom_id\x18\x03 \x01(\x03R\x06roomId\x12*\n" +
+	"\x11parent_message_id\x18\x04 \x01(\x03R\x0fparentMessageId\x12\x1e\n" +
+	"\vreply_to_id\x18\x05 \x01(\x03R\treplyToIdB\f\n" +
+	"\n" +
+	"_sender_id\"h\n" +
+	"\x13SendMessageResponse\x12\x18\n" +
+	"\asuccess\x18\x01 \x01(\bR\asuccess\x12\x18\n" +
+	"\amessage\x18\x02 \x01(\tR\amessage\x12\x1d\n" +
+	"\n" +
+	"message_id\x18\x03 \x01(\x03R\tmessageId\"\x8d\x01\n" +
+	"\x16GetRoomMessagesRequest\x12\x17\n" +
+	"\aroom_id\x18\x01 \x01(\x03R\x06roomId\x12 \n" +
+	"\auser_id\x18\x02 \x01(\x03B\x02\x18\x01H\x00R\x06userId\x88\x01\x01\x12\x14\n" +
+	"\x05limit\x18\x03 \x01(\x03R\x05limit\x12\x16\n" +
+	"\x06offset\x18\x04 \x01(\x03R\x06offsetB\n" +
+	"\n" +
+	"\b_user_id\"L\n" +
+	"\x17GetRoomMessagesResponse\x121\n" +
+	"\bmessages\x18\x01 \x03(\v2\x15.chat.MessageResponseR\bmessages\"\xc1\x01\n" +
+	"\x19StreamRoomMessagesRequest\x12\x17\n" +
+	"\aroom_id\x18\x01 \x01(\x03R\x06roomId\x12 \n" +
+	"\auser_id\x18\x02 \x01(\x03B\x02\x18\x01H\x00R\x06userId\x88\x01\x01\x12(\n" +
+	"\x10since_message_id\x18\x03 \x01(\x03R\x0esinceMessageId\x12\x16\n" +
+	"\x06cursor\x18\x04 \x01(\tR\x06cursor\x12\x1b\n" +
+	"\tthread_id\x18\x05 \x01(\x03R\bthreadIdB\n" +
+	"\n" +
+	"\b_user_id\"\x84\x05\n" +
+	"\x0fMessageResponse\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\x03R\x02id\x12\x18\n" +
+	"\acontent\x18\x02 \x01(\tR\acontent\x12\x1b\n" +
+	"\tsender_id\x18\x03 \x01(\x03R\bsenderId\x12\x17\n" +
+	"\aroom_id\x18\x04 \x01(\x03R\x06roomId\x12\x1f\n" +
+	"\vsender_name\x18\x05 \x01(\tR\n" +
+	"senderName\x12\x1c\n" +
+	"\ttimestamp\x18\x06 \x01(\tR\ttimestamp\x12\x1b\n" +
+	"\tedited_at\x18\a \x01(\tR\beditedAt\x12\x18\n" +
+	"\adeleted\x18\b \x01(\bR\adeleted\x12\x14\n" +
+	"\x05event\x18\t \x01(\tR\x05event\x12\x16\n" +
+	"\x06cursor\x18\n" +
+	" \x01(\tR\x06cursor\x121\n" +
+	"\treactions\x18\v \x03(\v2\x13.chat.ReactionCountR\treactions\x12*\n" +
+	"\x11parent_message_id\x18\f \x01(\x03R\x0fparentMessageId\x12\x1f\n" +
+	"\vreply_count\x18\r \x01(\x03R\n" +
+	"replyCount\x12\"\n" +
+	"\rlast_reply_at\x18\x0e \x01(\tR\vlastReplyAt\x12\x1e\n" +
+	"\vreply_to_id\x18\x0f \x01(\x03R\treplyToId\x123\n" +
+	"\x16forwarded_from_room_id\x18\x10 \x01(\x03R\x13forwardedFromRoomId\x127\n" +
+	"\x18forwarded_from_sender_id\x18\x11 \x01(\x03R\x15forwardedFromSenderId\x12;\n" +
+	"\x1aforwarded_from_sender_name\x18\x12 \x01(\tR\x17forwardedFromSenderName\"_\n" +
+	"\rReactionCount\x12\x14\n" +
+	"\x05emoji\x18\x01 \x01(\tR\x05emoji\x12\x14\n" +
+	"\x05count\x18\x02 \x01(\x03R\x05count\x12\"\n" +
+	"\rreacted_by_me\x18\x03 \x01(\bR\vreactedByMe\"M\n" +
+	"\x12EditMessageRequest\x12\x1d\n" +
+	"\n" +
+	"message_id\x18\x01 \x01(\x03R\tmessageId\x12\x18\n" +
+	"\acontent\x18\x02 \x01(\tR\acontent\"I\n" +
+	"\x13EditMessageResponse\x12\x18\n" +
+	"\asuccess\x18\x01 \x01(\bR\asuccess\x12\x18\n" +
+	"\amessage\x18\x02 \x01(\tR\amessage\"5\n" +
+	"\x14DeleteMessageRequest\x12\x1d\n" +
+	"\n" +
+	"message_id\x18\x01 \x01(\x03R\tmessageId\"K\n" +
+	"\x15DeleteMessageResponse\x12\x18\n" +
+	"\asuccess\x18\x01 \x01(\bR\asuccess\x12\x18\n" +
+	"\amessage\x18\x02 \x01(\tR\amessage\"O\n" +
+	"\x15ForwardMessageRequest\x12\x1d\n" +
+	"\n" +
+	"message_id\x18\x01 \x01(\x03R\tmessageId\x12\x17\n" +
+	"\aroom_id\x18\x02 \x01(\x03R\x06roomId\"k\n" +
+	"\x16ForwardMessageResponse\x12\x18\n" +
+	"\asuccess\x18\x01 \x01(\bR\asuccess\x12\x18\n" +
+	"\amessage\x18\x02 \x01(\tR\amessage\x12\x1d\n" +
+	"\n" +
+	"message_id\x18\x03 \x01(\x03R\tmessageId\"_\n" +
+	"\x10GetThreadRequest\x12\x1d\n" +
+	"\n" +
+	"message_id\x18\x01 \x01(\x03R\tmessageId\x12\x14\n" +
+	"\x05limit\x18\x02 \x01(\x03R\x05limit\x12\x16\n" +
+	"\x06offset\x18\x03 \x01(\x03R\x06offset\"\xa7\x01\n" +
+	"\x11GetThreadResponse\x12\x18\n" +
+	"\asuccess\x18\x01 \x01(\bR\asuccess\x12\x18\n" +
+	"\amessage\x18\x02 \x01(\tR\amessage\x12-\n" +
+	"\x06parent\x18\x03 \x01(\v2\x15.chat.MessageResponseR\x06parent\x12/\n" +
+	"\areplies\x18\x04 \x03(\v2\x15.chat.MessageResponseR\areplies\"I\n" +
+	"\x12AddReactionRequest\x12\x1d\n" +
+	"\n" +
+	"message_id\x18\x01 \x01(\x03R\tmessageId\x12\x14\n" +
+	"\x05emoji\x18\x02 \x01(\tR\x05emoji\"I\n" +
+	"\x13AddReactionResponse\x12\x18\n" +
+	"\asuccess\x18\x01 \x01(\bR\asuccess\x12\x18\n" +
+	"\amessage\x18\x02 \x01(\tR\amessage\"L\n" +
+	"\x15RemoveReactionRequest\x12\x1d\n" +
+	"\n" +
+	"message_id\x18\x01 \x01(\x03R\tmessageId\x12\x14\n" +
+	"\x05emoji\x18\x02 \x01(\tR\x05emoji\"L\n" +
+	"\x16RemoveReactionResponse\x12\x18\n" +
+	"\asuccess\x18\x01 \x01(\bR\asuccess\x12\x18\n" +
+	"\amessage\x18\x02 \x01(\tR\amessage\"\x91\x01\n" +
+	"\x17StreamRoomEventsRequest\x12\x17\n" +
+	"\aroom_id\x18\x01 \x01(\x03R\x06roomId\x12(\n" +
+	"\x10since_message_id\x18\x02 \x01(\x03R\x0esinceMessageId\x12\x16\n" +
+	"\x06cursor\x18\x03 \x01(\tR\x06cursor\x12\x1b\n" +
+	"\tthread_id\x18\x04 \x01(\x03R\bthreadId\"\xa9\x05\n" +
+	"\tRoomEvent\x12\x17\n" +
+	"\aroom_id\x18\x01 \x01(\x03R\x06roomId\x12\x1c\n" +
+	"\ttimestamp\x18\x02 \x01(\tR\ttimestamp\x121\n" +
+	"\amessage\x18\x03 \x01(\v2\x15.chat.MessageResponseH\x00R\amessage\x12>\n" +
+	"\x0emessage_edited\x18\x04 \x01(\v2\x15.chat.MessageResponseH\x00R\rmessageEdited\x12?\n" +
+	"\x0fmessage_deleted\x18\x05 \x01(\v2\x14.chat.MessageDeletedH\x00R\x0emessageDeleted\x121\n" +
+	"\breaction\x18\x06 \x01(\v2\x13.chat.ReactionEventH\x00R\breaction\x12+\n" +
+	"\x06typing\x18\a \x01(\v2\x11.chat.TypingEventH\x00R\x06typing\x121\n" +
+	"\bpresence\x18\b \x01(\v2\x13.chat.PresenceEventH\x00R\bpresence\x128\n" +
+	"\rmember_joined\x18\t \x01(\v2\x11.chat.MemberEventH\x00R\fmemberJoined\x124\n" +
+	"\vmember_left\x18\n" +
+	" \x01(\v2\x11.chat.MemberEventH\x00R\n" +
+	"memberLeft\x12;\n" +
+	"\froom_updated\x18\v \x01(\v2\x16.chat.RoomUpdatedEventH\x00R\vroomUpdated\x12;\n" +
+	"\froom_deleted\x18\f \x01(\v2\x16.chat.RoomDeletedEventH\x00R\vroomDeleted\x12+\n" +
+	"\x06resync\x18\r \x01(\v2\x11.chat.ResyncEventH\x00R\x06resyncB\a\n" +
+	"\x05event\"/\n" +
+	"\x0eMessageDeleted\x12\x1d\n" +
+	"\n" +
+	"message_id\x18\x01 \x01(\x03R\tmessageId\"\x8f\x01\n" +
+	"\rReactionEvent\x12\x1d\n" +
+	"\n" +
+	"message_id\x18\x01 \x01(\x03R\tmessageId\x12\x17\n" +
+	"\auser_id\x18\x02 \x01(\x03R\x06userId\x12\x14\n" +
+	"\x05emoji\x18\x03 \x01(\tR\x05emoji\x12\x14\n" +
+	"\x05added\x18\x04 \x01(\bR\x05added\x12\x1a\n" +
+	"\busername\x18\x05 \x01(\tR\busername\"Z\n" +
+	"\vTypingEvent\x12\x17\n" +
+	"\auser_id\x18\x01 \x01(\x03R\x06userId\x12\x1a\n" +
+	"\busername\x18\x02 \x01(\tR\busername\x12\x16\n" +
+	"\x06typing\x18\x03 \x01(\bR\x06typing\"\\\n" +
+	"\rPresenceEvent\x12\x17\n" +
+	"\auser_id\x18\x01 \x01(\x03R\x06userId\x12\x1a\n" +
+	"\busername\x18\x02 \x01(\tR\busername\x12\x16\n" +
+	"\x06online\x18\x03 \x01(\bR\x06online\"B\n" +
+	"\vMemberEvent\x12\x17\n" +
+	"\auser_id\x18\x01 \x01(\x03R\x06userId\x12\x1a\n" +
+	"\busername\x18\x02 \x01(\tR\busername\"\x99\x01\n" +
+	"\x10RoomUpdatedEvent\x12\x12\n" +
+	"\x04name\x18\x01 \x01(\tR\x04name\x12 \n" +
+	"\vdescription\x18\x02 \x01(\tR\vdescription\x12\x14\n" +
+	"\x05topic\x18\x03 \x01(\tR\x05topic\x12\x1d\n" +
+	"\n" +
+	"avatar_url\x18\x04 \x01(\tR\tavatarUrl\x12\x1a\n" +
+	"\barchived\x18\x05 \x01(\bR\barchived\"\x12\n" +
+	"\x10RoomDeletedEvent\"\r\n" +
+	"\vResyncEvent\"D\n" +
+	"\x11SendTypingRequest\x12\x17\n" +
+	"\aroom_id\x18\x01 \x01(\x03R\x06roomId\x12\x16\n" +
+	"\x06typing\x18\x02 \x01(\bR\x06typing\"H\n" +
+	"\x12SendTypingResponse\x12\x18\n" +
+	"\asuccess\x18\x01 \x01(\bR\asuccess\x12\x18\n" +
+	"\amessage\x18\x02 \x01(\tR\amessage\"\x17\n" +
+	"\x15GetStreamStatsRequest\"\xbd\x01\n" +
+	"\x16GetStreamStatsResponse\x12\x18\n" +
+	"\asuccess\x18\x01 \x01(\bR\asuccess\x12\x18\n" +
+	"\amessage\x18\x02 \x01(\tR\amessage\x12!\n" +
+	"\fopen_streams\x18\x03 \x01(\x03R\vopenStreams\x12%\n" +
+	"\x0edropped_events\x18\x04 \x01(\x03R\rdroppedEvents\x12%\n" +
+	"\x0eslow_consumers\x18\x05 \x01(\x03R\rslowConsumers2\xf1\t\n" +
+	"\vChatService\x12a\n" +
+	"\vSendMessage\x12\x18.chat.SendMessageRequest\x1a\x19.chat.SendMessageResponse\"\x1d\x82\xd3\xe4\x93\x02\x17:\x01*\"\x12/chat/send-message\x12r\n" +
+	"\x0fGetRoomMessages\x12\x1c.chat.GetRoomMessagesRequest\x1a\x1d.chat.GetRoomMessagesResponse\"\"\x82\xd3\xe4\x93\x02\x1c:\x01*\"\x17/chat/get-room-messages\x12p\n" +
+	"\x12StreamRoomMessages\x12\x1f.chat.StreamRoomMessagesRequest\x1a\x15.chat.MessageResponse\" \x82\xd3\xe4\x93\x02\x1a:\x01*\"\x15/chat/stream-messages0\x01\x12a\n" +
+	"\vEditMessage\x12\x18.chat.EditMessageRequest\x1a\x19.chat.EditMessageResponse\"\x1d\x82\xd3\xe4\x93\x02\x17:\x01*\"\x12/chat/edit-message\x12i\n" +
+	"\rDeleteMessage\x12\x1a.chat.DeleteMessageRequest\x1a\x1b.chat.DeleteMessageResponse\"\x1f\x82\xd3\xe4\x93\x02\x19:\x01*\"\x14/chat/delete-message\x12m\n" +
+	"\x0eForwardMessage\x12\x1b.chat.ForwardMessageRequest\x1a\x1c.chat.ForwardMessageResponse\" \x82\xd3\xe4\x93\x02\x1a:\x01*\"\x15/chat/forward-message\x12Y\n" +
+	"\tGetThread\x12\x16.chat.GetThreadRequest\x1a\x17.chat.GetThreadResponse\"\x1b\x82\xd3\xe4\x93\x02\x15:\x01*\"\x10/chat/get-thread\x12a\n" +
+	"\vAddReaction\x12\x18.chat.AddReactionRequest\x1a\x19.chat.AddReactionResponse\"\x1d\x82\xd3\xe4\x93\x02\x17:\x01*\"\x12/chat/add-reaction\x12m\n" +
+	"\x0eRemoveReaction\x12\x1b.chat.RemoveReactionRequest\x1a\x1c.chat.RemoveReactionResponse\" \x82\xd3\xe4\x93\x02\x1a:\x01*\"\x15/chat/remove-reaction\x12d\n" +
+	"\x10StreamRoomEvents\x12\x1d.chat.StreamRoomEventsRequest\x1a\x0f.chat.RoomEvent\"\x1e\x82\xd3\xe4\x93\x02\x18:\x01*\"\x13/chat/stream-events0\x01\x12]\n" +
+	"\n" +
+	"SendTyping\x12\x17.chat.SendTypingRequest\x1a\x18.chat.SendTypingResponse\"\x1c\x82\xd3\xe4\x93\x02\x16:\x01*\"\x11/chat/send-typing\x12j\n" +
+	"\x0eGetStreamStats\x12\x1b.chat.GetStreamStatsRequest\x1a\x1c.chat.GetStreamStatsResponse\"\x1d\x82\xd3\xe4\x93\x02\x17:\x01*\"\x12/chat/stream-statsB Z\x1egrpc-messenger-core/proto/chatb\x06proto3"
+
+var (
+	file_proto_chat_chat_proto_rawDescOnce sync.Once
+	file_proto_chat_chat_proto_rawDescData []byte
+)
+
+func file_proto_chat_chat_proto_rawDescGZIP() []byte {
+	file_proto_chat_chat_proto_rawDescOnce.Do(func() {
+		file_proto_chat_chat_proto_rawDescData = protoimpl.X.CompressGZIP(unsafe.Slice(unsafe.StringData(file_proto_chat_chat_proto_rawDesc), len(file_proto_chat_chat_proto_rawDesc)))
+	})
+	return file_proto_chat_chat_proto_rawDescData
+}
+
+var file_proto_chat_chat_proto_msgTypes = make([]protoimpl.MessageInfo, 33)
+var file_proto_chat_chat_proto_goTypes = []any{
+	(*SendMessageRequest)(nil),        // 0: chat.SendMessageRequest
+	(*SendMessageResponse)(nil),       // 1: chat.SendMessageResponse
+	(*GetRoomMessagesRequest)(nil),    // 2: chat.GetRoomMessagesRequest
+	(*GetRoomMessagesResponse)(nil),   // 3: chat.GetRoomMessagesResponse
+	(*StreamRoomMessagesRequest)(nil), // 4: chat.StreamRoomMessagesRequest
+	(*MessageResponse)(nil),           // 5: chat.MessageResponse
+	(*ReactionCount)(nil),             // 6: chat.ReactionCount
+	(*EditMessageRequest)(nil),        // 7: chat.EditMessageRequest
+	(*EditMessageResponse)(nil),       // 8: chat.EditMessageResponse
+	(*DeleteMessageRequest)(nil),      // 9: chat.DeleteMessageRequest
+	(*DeleteMessageResponse)(nil),     // 10: chat.DeleteMessageResponse
+	(*ForwardMessageRequest)(nil),     // 11: chat.ForwardMessageRequest
+	(*ForwardMessageResponse)(nil),    // 12: chat.ForwardMessageResponse
+	(*GetThreadRequest)(nil),          // 13: chat.GetThreadRequest
+	(*GetThreadResponse)(nil),         // 14: chat.GetThreadResponse
+	(*AddReactionRequest)(nil),        // 15: chat.AddReactionRequest
+	(*AddReactionResponse)(nil),       // 16: chat.AddReactionResponse
+	(*RemoveReactionRequest)(nil),     // 17: chat.RemoveReactionRequest
+	(*RemoveReactionResponse)(nil),    // 18: chat.RemoveReactionResponse
+	(*StreamRoomEventsRequest)(nil),   // 19: chat.StreamRoomEventsRequest
+	(*RoomEvent)(nil),                 // 20: chat.RoomEvent
+	(*MessageDeleted)(nil),            // 21: chat.MessageDeleted
+	(*ReactionEvent)(nil),             // 22: chat.ReactionEvent
+	(*TypingEvent)(nil),               // 23: chat.TypingEvent
+	(*PresenceEvent)(nil),             // 24: chat.PresenceEvent
+	(*MemberEvent)(nil),               // 25: chat.MemberEvent
+	(*RoomUpdatedEvent)(nil),          // 26: chat.RoomUpdatedEvent
+	(*RoomDeletedEvent)(nil),          // 27: chat.RoomDeletedEvent
+	(*ResyncEvent)(nil),               // 28: chat.ResyncEvent
+	(*SendTypingRequest)(nil),         // 29: chat.SendTypingRequest
+	(*SendTypingResponse)(nil),        // 30: chat.SendTypingResponse
+	(*GetStreamStatsRequest)(nil),     // 31: chat.GetStreamStatsRequest
+	(*GetStreamStatsResponse)(nil),    // 32: chat.GetStreamStatsResponse
+}
+var file_proto_chat_chat_proto_depIdxs = []int32{
+	5,  // 0: chat.GetRoomMessagesResponse.messages:type_name -> chat.MessageResponse
+	6,  // 1: chat.MessageResponse.reactions:type_name -> chat.ReactionCount
+	5,  // 2: chat.GetThreadResponse.parent:type_name -> chat.MessageResponse
+	5,  // 3: chat.GetThreadResponse.replies:type_name -> chat.MessageResponse
+	5,  // 4: chat.RoomEvent.message:type_name -> chat.MessageResponse
+	5,  // 5: chat.RoomEvent.message_edited:type_name -> chat.MessageResponse
+	21, // 6: chat.RoomEvent.message_deleted:type_name -> chat.MessageDeleted
+	22, // 7: chat.RoomEvent.reaction:type_name -> chat.ReactionEvent
+	23, // 8: chat.RoomEvent.typing:type_name -> chat.TypingEvent
+	24, // 9: chat.RoomEvent.presence:type_name -> chat.PresenceEvent
+	25, // 10: chat.RoomEvent.member_joined:type_name -> chat.MemberEvent
+	25, // 11: chat.RoomEvent.member_left:type_name -> chat.MemberEvent
+	26, // 12: chat.RoomEvent.room_updated:type_name -> chat.RoomUpdatedEvent
+	27, // 13: chat.RoomEvent.room_deleted:type_name -> chat.RoomDeletedEvent
+	28, // 14: chat.RoomEvent.resync:type_name -> chat.ResyncEvent
+	0,  // 15: chat.ChatService.SendMessage:input_type -> chat.SendMessageRequest
+	2,  // 16: chat.ChatService.GetRoomMessages:input_type -> chat.GetRoomMessagesRequest
+	4,  // 17: chat.ChatService.StreamRoomMessages:input_type -> chat.StreamRoomMessagesRequest
+	7,  // 18: chat.ChatService.EditMessage:input_type -> chat.EditMessageRequest
+	9,  // 19: chat.ChatService.DeleteMessage:input_type -> chat.DeleteMessageRequest
+	11, // 20: chat.ChatService.ForwardMessage:input_type -> chat.ForwardMessageRequest
+	13, // 21: chat.ChatService.GetThread:input_type -> chat.GetThreadRequest
+	15, // 22: chat.ChatService.AddReaction:input_type -> chat.AddReactionRequest
+	17, // 23: chat.ChatService.RemoveReaction:input_type -> chat.RemoveReactionRequest
+	19, // 24: chat.ChatService.StreamRoomEvents:input_type -> chat.StreamRoomEventsRequest
+	29, // 25: chat.ChatService.SendTyping:input_type -> chat.SendTypingRequest
+	31, // 26: chat.ChatService.GetStreamStats:input_type -> chat.GetStreamStatsRequest
+	1,  // 27: chat.ChatService.SendMessage:output_type -> chat.SendMessageResponse
+	3,  // 28: chat.ChatService.GetRoomMessages:output_type -> chat.GetRoomMessagesResponse
+	5,  // 29: chat.ChatService.StreamRoomMessages:output_type -> chat.MessageResponse
+	8,  // 30: chat.ChatService.EditMessage:output_type -> chat.EditMessageResponse
+	10, // 31: chat.ChatService.DeleteMessage:output_type -> chat.DeleteMessageResponse
+	12, // 32: chat.ChatService.ForwardMessage:output_type -> chat.ForwardMessageResponse
+	14, // 33: chat.ChatService.GetThread:output_type -> chat.GetThreadResponse
+	16, // 34: chat.ChatService.AddReaction:output_type -> chat.AddReactionResponse
+	18, // 35: chat.ChatService.RemoveReaction:output_type -> chat.RemoveReactionResponse
+	20, // 36: chat.ChatService.StreamRoomEvents:output_type -> chat.RoomEvent
+	30, // 37: chat.ChatService.SendTyping:output_type -> chat.SendTypingResponse
+	32, // 38: chat.ChatService.GetStreamStats:output_type -> chat.GetStreamStatsResponse
+	27, // [27:39] is the sub-list for method output_type
+	15, // [15:27] is the sub-list for method input_type
+	15, // [15:15] is the sub-list for extension type_name
+	15, // [15:15] is the sub-list for extension extendee
+	0,  // [0:15] is the sub-list for field type_name
+}
+
+func init() { file_proto_chat_chat_proto_init() }
+func file_proto_chat_chat_proto_init() {
+	if File_proto_chat_chat_proto != nil {
+		return
+	}
+	file_proto_chat_chat_proto_msgTypes[0].OneofWrappers = []any{}
+	file_proto_chat_chat_proto_msgTypes[2].OneofWrappers = []any{}
+	file_proto_chat_chat_proto_msgTypes[4].OneofWrappers = []any{}
+	file_proto_chat_chat_proto_msgTypes[20].OneofWrappers = []any{
+		(*RoomEvent_Message)(nil),
+		(*RoomEvent_MessageEdited)(nil),
+		(*RoomEvent_MessageDeleted)(nil),
+		(*RoomEvent_Reaction)(nil),
+		(*RoomEvent_Typing)(nil),
+		(*RoomEvent_Presence)(nil),
+		(*RoomEvent_MemberJoined)(nil),
+		(*RoomEvent_MemberLeft)(nil),
+		(*RoomEvent_RoomUpdated)(nil),
+		(*RoomEvent_RoomDeleted)(nil),
+		(*RoomEvent_Resync)(nil),
 	}
 	type x struct{}
 	out := protoimpl.TypeBuilder{
@@ -480,7 +2601,7 @@ func file_proto_chat_chat_proto_init() {
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: unsafe.Slice(unsafe.StringData(file_proto_chat_chat_proto_rawDesc), len(file_proto_chat_chat_proto_rawDesc)),
 			NumEnums:      0,
-			NumMessages:   6,
+			NumMessages:   33,
 			NumExtensions: 0,
 			NumServices:   1,
 		},
@@ -24,10 +24,21 @@ const (
 
 // Request to create a room
 type CreateRoomRequest struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Name          string                 `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
-	Description   string                 `protobuf:"bytes,2,opt,name=description,proto3" json:"description,omitempty"`
-	CreatorId     int64                  `protobuf:"varint,3,opt,name=creator_id,json=creatorId,proto3" json:"creator_id,omitempty"`
+	state       protoimpl.MessageState `protogen:"open.v1"`
+	Name        string                 `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	Description string                 `protobuf:"bytes,2,opt,name=description,proto3" json:"description,omitempty"`
+	// Deprecated: the creator is taken from the access token. If set, it must
+	// match the authenticated user.
+	//
+	// Deprecated: Marked as deprecated in proto/room/room.proto.
+	CreatorId *int64 `protobuf:"varint,3,opt,name=creator_id,json=creatorId,proto3,oneof" json:"creator_id,omitempty"`
+	// Private rooms are hidden from the room directory and default to the
+	// "invite_only" join policy
+	IsPrivate bool `protobuf:"varint,4,opt,name=is_private,json=isPrivate,proto3" json:"is_private,omitempty"`
+	// Maximum number of members, or 0 for no limit
+	MaxMembers int64 `protobuf:"varint,5,opt,name=max_members,json=maxMembers,proto3" json:"max_members,omitempty"`
+	// "open", "approval" or "invite_only"
+	JoinPolicy    string `protobuf:"bytes,6,opt,name=join_policy,json=joinPolicy,proto3" json:"join_policy,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
@@ -76,20 +87,55 @@ func (x *CreateRoomRequest) GetDescription() string {
 	return ""
 }
 
+// Deprecated: Marked as deprecated in proto/room/room.proto.
 func (x *CreateRoomRequest) GetCreatorId() int64 {
+	if x != nil && x.CreatorId != nil {
+		return *x.CreatorId
+	}
+	return 0
+}
+
+func (x *CreateRoomRequest) GetIsPrivate() bool {
 	if x != nil {
-		return x.CreatorId
+		return x.IsPrivate
+	}
+	return false
+}
+
+func (x *CreateRoomRequest) GetMaxMembers() int64 {
+	if x != nil {
+		return x.MaxMembers
 	}
 	return 0
 }
 
+func (x *CreateRoomRequest) GetJoinPolicy() string {
+	if x != nil {
+		return x.JoinPolicy
+	}
+	return ""
+}
+
 // Room response
 type RoomResponse struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Id            int64                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
-	Name          string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
-	Description   string                 `protobuf:"bytes,3,opt,name=description,proto3" json:"description,omitempty"`
-	CreatorId     int64                  `protobuf:"varint,4,opt,name=creator_id,json=creatorId,proto3" json:"creator_id,omitempty"`
+	state       protoimpl.MessageState `protogen:"open.v1"`
+	Id          int64                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	Name        string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	Description string                 `protobuf:"bytes,3,opt,name=description,proto3" json:"description,omitempty"`
+	CreatorId   int64                  `protobuf:"varint,4,opt,name=creator_id,json=creatorId,proto3" json:"creator_id,omitempty"`
+	IsPrivate   bool                   `protobuf:"varint,5,opt,name=is_private,json=isPrivate,proto3" json:"is_private,omitempty"`
+	Topic       string                 `protobuf:"bytes,6,opt,name=topic,proto3" json:"topic,omitempty"`
+	AvatarUrl   string                 `protobuf:"bytes,7,opt,name=avatar_url,json=avatarUrl,proto3" json:"avatar_url,omitempty"`
+	// Archived rooms are read-only
+	Archived bool `protobuf:"varint,8,opt,name=archived,proto3" json:"archived,omitempty"`
+	// Only set where noted
+	MemberCount    int64  `protobuf:"varint,9,opt,name=member_count,json=memberCount,proto3" json:"member_count,omitempty"`
+	LastActivityAt string `protobuf:"bytes,10,opt,name=last_activity_at,json=lastActivityAt,proto3" json:"last_activity_at,omitempty"`
+	// 0 means no limit
+	MaxMembers int64  `protobuf:"varint,11,opt,name=max_members,json=maxMembers,proto3" json:"max_members,omitempty"`
+	JoinPolicy string `protobuf:"bytes,12,opt,name=join_policy,json=joinPolicy,proto3" json:"join_policy,omitempty"`
+	// "room", "direct" or "group"
+	Kind          string `protobuf:"bytes,13,opt,name=kind,proto3" json:"kind,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
@@ -152,10 +198,77 @@ func (x *RoomResponse) GetCreatorId() int64 {
 	return 0
 }
 
+func (x *RoomResponse) GetIsPrivate() bool {
+	if x != nil {
+		return x.IsPrivate
+	}
+	return false
+}
+
+func (x *RoomResponse) GetTopic() string {
+	if x != nil {
+		return x.Topic
+	}
+	return ""
+}
+
+func (x *RoomResponse) GetAvatarUrl() string {
+	if x != nil {
+		return x.AvatarUrl
+	}
+	return ""
+}
+
+func (x *RoomResponse) GetArchived() bool {
+	if x != nil {
+		return x.Archived
+	}
+	return false
+}
+
+func (x *RoomResponse) GetMemberCount() int64 {
+	if x != nil {
+		return x.MemberCount
+	}
+	return 0
+}
+
+func (x *RoomResponse) GetLastActivityAt() string {
+	if x != nil {
+		return x.LastActivityAt
+	}
+	return ""
+}
+
+func (x *RoomResponse) GetMaxMembers() int64 {
+	if x != nil {
+		return x.MaxMembers
+	}
+	return 0
+}
+
+func (x *RoomResponse) GetJoinPolicy() string {
+	if x != nil {
+		return x.JoinPolicy
+	}
+	return ""
+}
+
+func (x *RoomResponse) GetKind() string {
+	if x != nil {
+		return x.Kind
+	}
+	return ""
+}
+
 // Request to get rooms
 type GetRoomsRequest struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	UserId        int64                  `protobuf:"varint,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Deprecated: the user is taken from the access token. If set, it must
+	// match the authenticated user.
+	//
+	// Deprecated: Marked as deprecated in proto/room/room.proto.
+	UserId        *int64 `protobuf:"varint,1,opt,name=user_id,json=userId,proto3,oneof" json:"user_id,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
@@ -190,19 +303,22 @@ func (*GetRoomsRequest) Descriptor() ([]byte, []int) {
 	return file_proto_room_room_proto_rawDescGZIP(), []int{2}
 }
 
+// Deprecated: Marked as deprecated in proto/room/room.proto.
 func (x *GetRoomsRequest) GetUserId() int64 {
-	if x != nil {
-		return x.UserId
+	if x != nil && x.UserId != nil {
+		return *x.UserId
 	}
 	return 0
 }
 
 // Response to a get rooms request
 type GetRoomsResponse struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Rooms         []*RoomResponse        `protobuf:"bytes,1,rep,name=rooms,proto3" json:"rooms,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+	state               protoimpl.MessageState `protogen:"open.v1"`
+	Rooms               []*RoomResponse        `protobuf:"bytes,1,rep,name=rooms,proto3" json:"rooms,omitempty"`
+	DirectConversations []*DirectConversation  `protobuf:"bytes,2,rep,name=direct_conversations,json=directConversations,proto3" json:"direct_conversations,omitempty"`
+	GroupConversations  []*GroupConversation   `protobuf:"bytes,3,rep,name=group_conversations,json=groupConversations,proto3" json:"group_conversations,omitempty"`
+	unknownFields       protoimpl.UnknownFields
+	sizeCache           protoimpl.SizeCache
 }
 
 func (x *GetRoomsResponse) Reset() {
@@ -242,11 +358,31 @@ func (x *GetRoomsResponse) GetRooms() []*RoomResponse {
 	return nil
 }
 
+func (x *GetRoomsResponse) GetDirectConversations() []*DirectConversation {
+	if x != nil {
+		return x.DirectConversations
+	}
+	return nil
+}
+
+func (x *GetRoomsResponse) GetGroupConversations() []*GroupConversation {
+	if x != nil {
+		return x.GroupConversations
+	}
+	return nil
+}
+
 // Request to join a room
 type JoinRoomRequest struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	RoomId        int64                  `protobuf:"varint,1,opt,name=room_id,json=roomId,proto3" json:"room_id,omitempty"`
-	UserId        int64                  `protobuf:"varint,2,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	state  protoimpl.MessageState `protogen:"open.v1"`
+	RoomId int64                  `protobuf:"varint,1,opt,name=room_id,json=roomId,proto3" json:"room_id,omitempty"`
+	// Deprecated: the user is taken from the access token. If set, it must
+	// match the authenticated user.
+	//
+	// Deprecated: Marked as deprecated in proto/room/room.proto.
+	UserId *int64 `protobuf:"varint,2,opt,name=user_id,json=userId,proto3,oneof" json:"user_id,omitempty"`
+	// Shown to room admins when the room requires approval
+	Message       string `protobuf:"bytes,3,opt,name=message,proto3" json:"message,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
@@ -288,18 +424,29 @@ func (x *JoinRoomRequest) GetRoomId() int64 {
 	return 0
 }
 
+// Deprecated: Marked as deprecated in proto/room/room.proto.
 func (x *JoinRoomRequest) GetUserId() int64 {
-	if x != nil {
-		return x.UserId
+	if x != nil && x.UserId != nil {
+		return *x.UserId
 	}
 	return 0
 }
 
+func (x *JoinRoomRequest) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
 // Response to a join room request
 type JoinRoomResponse struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Success       bool                   `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
-	Message       string                 `protobuf:"bytes,2,opt,name=message,proto3" json:"message,omitempty"`
+	state   protoimpl.MessageState `protogen:"open.v1"`
+	Success bool                   `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
+	Message string                 `protobuf:"bytes,2,opt,name=message,proto3" json:"message,omitempty"`
+	// Set when the room requires approval and a join request was created
+	Pending       bool  `protobuf:"varint,3,opt,name=pending,proto3" json:"pending,omitempty"`
+	JoinRequestId int64 `protobuf:"varint,4,opt,name=join_request_id,json=joinRequestId,proto3" json:"join_request_id,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
@@ -348,11 +495,29 @@ func (x *JoinRoomResponse) GetMessage() string {
 	return ""
 }
 
+func (x *JoinRoomResponse) GetPending() bool {
+	if x != nil {
+		return x.Pending
+	}
+	return false
+}
+
+func (x *JoinRoomResponse) GetJoinRequestId() int64 {
+	if x != nil {
+		return x.JoinRequestId
+	}
+	return 0
+}
+
 // Request to leave a room
 type LeaveRoomRequest struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	RoomId        int64                  `protobuf:"varint,1,opt,name=room_id,json=roomId,proto3" json:"room_id,omitempty"`
-	UserId        int64                  `protobuf:"varint,2,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	state  protoimpl.MessageState `protogen:"open.v1"`
+	RoomId int64                  `protobuf:"varint,1,opt,name=room_id,json=roomId,proto3" json:"room_id,omitempty"`
+	// Deprecated: the user is taken from the access token. If set, it must
+	// match the authenticated user.
+	//
+	// Deprecated: Marked as deprecated in proto/room/room.proto.
+	UserId        *int64 `protobuf:"varint,2,opt,name=user_id,json=userId,proto3,oneof" json:"user_id,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
@@ -394,9 +559,10 @@ func (x *LeaveRoomRequest) GetRoomId() int64 {
 	return 0
 }
 
+// Deprecated: Marked as deprecated in proto/room/room.proto.
 func (x *LeaveRoomRequest) GetUserId() int64 {
-	if x != nil {
-		return x.UserId
+	if x != nil && x.UserId != nil {
+		return *x.UserId
 	}
 	return 0
 }
@@ -454,44 +620,3993 @@ func (x *LeaveRoomResponse) GetMessage() string {
 	return ""
 }
 
-var File_proto_room_room_proto protoreflect.FileDescriptor
+// Request to change a member's role
+type SetMemberRoleRequest struct {
+	state  protoimpl.MessageState `protogen:"open.v1"`
+	RoomId int64                  `protobuf:"varint,1,opt,name=room_id,json=roomId,proto3" json:"room_id,omitempty"`
+	UserId int64                  `protobuf:"varint,2,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	// One of "member", "moderator" or "admin"
+	Role          string `protobuf:"bytes,3,opt,name=role,proto3" json:"role,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
 
-const file_proto_room_room_proto_rawDesc = "" +
-	"\n" +
-	"\x15proto/room/room.proto\x12\x04room\x1a\x1cgoogle/api/annotations.proto\"h\n" +
-	"\x11CreateRoomRequest\x12\x12\n" +
-	"\x04name\x18\x01 \x01(\tR\x04name\x12 \n" +
-	"\vdescription\x18\x02 \x01(\tR\vdescription\x12\x1d\n" +
-	"\n" +
-	"creator_id\x18\x03 \x01(\x03R\tcreatorId\"s\n" +
-	"\fRoomResponse\x12\x0e\n" +
-	"\x02id\x18\x01 \x01(\x03R\x02id\x12\x12\n" +
-	"\x04name\x18\x02 \x01(\tR\x04name\x12 \n" +
-	"\vdescription\x18\x03 \x01(\tR\vdescription\x12\x1d\n" +
-	"\n" +
-	"creator_id\x18\x04 \x01(\x03R\tcreatorId\"*\n" +
-	"\x0fGetRoomsRequest\x12\x17\n" +
-	"\auser_id\x18\x01 \x01(\x03R\x06userId\"<\n" +
-	"\x10GetRoomsResponse\x12(\n" +
-	"\x05rooms\x18\x01 \x03(\v2\x12.room.RoomResponseR\x05rooms\"C\n" +
-	"\x0fJoinRoomRequest\x12\x17\n" +
-	"\aroom_id\x18\x01 \x01(\x03R\x06roomId\x12\x17\n" +
-	"\auser_id\x18\x02 \x01(\x03R\x06userId\"F\n" +
-	"\x10JoinRoomResponse\x12\x18\n" +
-	"\asuccess\x18\x01 \x01(\bR\asuccess\x12\x18\n" +
-	"\amessage\x18\x02 \x01(\tR\amessage\"D\n" +
-	"\x10LeaveRoomRequest\x12\x17\n" +
-	"\aroom_id\x18\x01 \x01(\x03R\x06roomId\x12\x17\n" +
-	"\auser_id\x18\x02 \x01(\x03R\x06userId\"G\n" +
-	"\x11LeaveRoomResponse\x12\x18\n" +
-	"\asuccess\x18\x01 \x01(\bR\asuccess\x12\x18\n" +
-	"\amessage\x18\x02 \x01(\tR\amessage2\xef\x02\n" +
-	"\vRoomService\x12W\n" +
-	"\n" +
-	"CreateRoom\x12\x17.room.CreateRoomRequest\x1a\x12.room.RoomResponse\"\x1c\x82\xd3\xe4\x93\x02\x16:\x01*\"\x11/room/create-room\x12U\n" +
-	"\bGetRooms\x12\x15.room.GetRoomsRequest\x1a\x16.room.GetRoomsResponse\"\x1a\x82\xd3\xe4\x93\x02\x14:\x01*\"\x0f/room/get-rooms\x12U\n" +
-	"\bJoinRoom\x12\x15.room.JoinRoomRequest\x1a\x16.room.JoinRoomResponse\"\x1a\x82\xd3\xe4\x93\x02\x14:\x01*\"\x0f/room/join-room\x12Y\n" +
-	"\tLeaveRoom\x12\x16.room.LeaveRoomRequest\x1a\x17.room.LeaveRoomResponse\"\x1b\x82\xd3\xe4\x93\x02\x15:\x01*\"\x10/room/leave-roomB Z\x1egrpc-messenger-core/proto/roomb\x06proto3"
+func (x *SetMemberRoleRequest) Reset() {
+	*x = SetMemberRoleRequest{}
+	mi := &file_proto_room_room_proto_msgTypes[8]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SetMemberRoleRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SetMemberRoleRequest) ProtoMessage() {}
+
+func (x *SetMemberRoleRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_room_room_proto_msgTypes[8]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SetMemberRoleRequest.ProtoReflect.Descriptor instead.
+func (*SetMemberRoleRequest) Descriptor() ([]byte, []int) {
+	return file_proto_room_room_proto_rawDescGZIP(), []int{8}
+}
+
+func (x *SetMemberRoleRequest) GetRoomId() int64 {
+	if x != nil {
+		return x.RoomId
+	}
+	return 0
+}
+
+func (x *SetMemberRoleRequest) GetUserId() int64 {
+	if x != nil {
+		return x.UserId
+	}
+	return 0
+}
+
+func (x *SetMemberRoleRequest) GetRole() string {
+	if x != nil {
+		return x.Role
+	}
+	return ""
+}
+
+// Response to a set member role request
+type SetMemberRoleResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Success       bool                   `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
+	Message       string                 `protobuf:"bytes,2,opt,name=message,proto3" json:"message,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SetMemberRoleResponse) Reset() {
+	*x = SetMemberRoleResponse{}
+	mi := &file_proto_room_room_proto_msgTypes[9]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SetMemberRoleResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SetMemberRoleResponse) ProtoMessage() {}
+
+func (x *SetMemberRoleResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_room_room_proto_msgTypes[9]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SetMemberRoleResponse.ProtoReflect.Descriptor instead.
+func (*SetMemberRoleResponse) Descriptor() ([]byte, []int) {
+	return file_proto_room_room_proto_rawDescGZIP(), []int{9}
+}
+
+func (x *SetMemberRoleResponse) GetSuccess() bool {
+	if x != nil {
+		return x.Success
+	}
+	return false
+}
+
+func (x *SetMemberRoleResponse) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+// Request to transfer ownership of a room
+type TransferOwnershipRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	RoomId        int64                  `protobuf:"varint,1,opt,name=room_id,json=roomId,proto3" json:"room_id,omitempty"`
+	NewOwnerId    int64                  `protobuf:"varint,2,opt,name=new_owner_id,json=newOwnerId,proto3" json:"new_owner_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *TransferOwnershipRequest) Reset() {
+	*x = TransferOwnershipRequest{}
+	mi := &file_proto_room_room_proto_msgTypes[10]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *TransferOwnershipRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TransferOwnershipRequest) ProtoMessage() {}
+
+func (x *TransferOwnershipRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_room_room_proto_msgTypes[10]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TransferOwnershipRequest.ProtoReflect.Descriptor instead.
+func (*TransferOwnershipRequest) Descriptor() ([]byte, []int) {
+	return file_proto_room_room_proto_rawDescGZIP(), []int{10}
+}
+
+func (x *TransferOwnershipRequest) GetRoomId() int64 {
+	if x != nil {
+		return x.RoomId
+	}
+	return 0
+}
+
+func (x *TransferOwnershipRequest) GetNewOwnerId() int64 {
+	if x != nil {
+		return x.NewOwnerId
+	}
+	return 0
+}
+
+// Response to a transfer ownership request
+type TransferOwnershipResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Success       bool                   `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
+	Message       string                 `protobuf:"bytes,2,opt,name=message,proto3" json:"message,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *TransferOwnershipResponse) Reset() {
+	*x = TransferOwnershipResponse{}
+	mi := &file_proto_room_room_proto_msgTypes[11]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *TransferOwnershipResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TransferOwnershipResponse) ProtoMessage() {}
+
+func (x *TransferOwnershipResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_room_room_proto_msgTypes[11]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TransferOwnershipResponse.ProtoReflect.Descriptor instead.
+func (*TransferOwnershipResponse) Descriptor() ([]byte, []int) {
+	return file_proto_room_room_proto_rawDescGZIP(), []int{11}
+}
+
+func (x *TransferOwnershipResponse) GetSuccess() bool {
+	if x != nil {
+		return x.Success
+	}
+	return false
+}
+
+func (x *TransferOwnershipResponse) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+// Request to invite a user to a room
+type InviteUserRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	RoomId        int64                  `protobuf:"varint,1,opt,name=room_id,json=roomId,proto3" json:"room_id,omitempty"`
+	UserId        int64                  `protobuf:"varint,2,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *InviteUserRequest) Reset() {
+	*x = InviteUserRequest{}
+	mi := &file_proto_room_room_proto_msgTypes[12]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *InviteUserRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*InviteUserRequest) ProtoMessage() {}
+
+func (x *InviteUserRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_room_room_proto_msgTypes[12]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use InviteUserRequest.ProtoReflect.Descriptor instead.
+func (*InviteUserRequest) Descriptor() ([]byte, []int) {
+	return file_proto_room_room_proto_rawDescGZIP(), []int{12}
+}
+
+func (x *InviteUserRequest) GetRoomId() int64 {
+	if x != nil {
+		return x.RoomId
+	}
+	return 0
+}
+
+func (x *InviteUserRequest) GetUserId() int64 {
+	if x != nil {
+		return x.UserId
+	}
+	return 0
+}
+
+// Response to an invite user request
+type InviteUserResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Success       bool                   `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
+	Message       string                 `protobuf:"bytes,2,opt,name=message,proto3" json:"message,omitempty"`
+	InviteId      int64                  `protobuf:"varint,3,opt,name=invite_id,json=inviteId,proto3" json:"invite_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *InviteUserResponse) Reset() {
+	*x = InviteUserResponse{}
+	mi := &file_proto_room_room_proto_msgTypes[13]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *InviteUserResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*InviteUserResponse) ProtoMessage() {}
+
+func (x *InviteUserResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_room_room_proto_msgTypes[13]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use InviteUserResponse.ProtoReflect.Descriptor instead.
+func (*InviteUserResponse) Descriptor() ([]byte, []int) {
+	return file_proto_room_room_proto_rawDescGZIP(), []int{13}
+}
+
+func (x *InviteUserResponse) GetSuccess() bool {
+	if x != nil {
+		return x.Success
+	}
+	return false
+}
+
+func (x *InviteUserResponse) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+func (x *InviteUserResponse) GetInviteId() int64 {
+	if x != nil {
+		return x.InviteId
+	}
+	return 0
+}
+
+// Pending room invite
+type Invite struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            int64                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	RoomId        int64                  `protobuf:"varint,2,opt,name=room_id,json=roomId,proto3" json:"room_id,omitempty"`
+	RoomName      string                 `protobuf:"bytes,3,opt,name=room_name,json=roomName,proto3" json:"room_name,omitempty"`
+	InviterId     int64                  `protobuf:"varint,4,opt,name=inviter_id,json=inviterId,proto3" json:"inviter_id,omitempty"`
+	InviterName   string                 `protobuf:"bytes,5,opt,name=inviter_name,json=inviterName,proto3" json:"inviter_name,omitempty"`
+	CreatedAt     string                 `protobuf:"bytes,6,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	ExpiresAt     string                 `protobuf:"bytes,7,opt,name=expires_at,json=expiresAt,proto3" json:"expires_at,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Invite) Reset() {
+	*x = Invite{}
+	mi := &file_proto_room_room_proto_msgTypes[14]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Invite) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Invite) ProtoMessage() {}
+
+func (x *Invite) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_room_room_proto_msgTypes[14]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Invite.ProtoReflect.Descriptor instead.
+func (*Invite) Descriptor() ([]byte, []int) {
+	return file_proto_room_room_proto_rawDescGZIP(), []int{14}
+}
+
+func (x *Invite) GetId() int64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *Invite) GetRoomId() int64 {
+	if x != nil {
+		return x.RoomId
+	}
+	return 0
+}
+
+func (x *Invite) GetRoomName() string {
+	if x != nil {
+		return x.RoomName
+	}
+	return ""
+}
+
+func (x *Invite) GetInviterId() int64 {
+	if x != nil {
+		return x.InviterId
+	}
+	return 0
+}
+
+func (x *Invite) GetInviterName() string {
+	if x != nil {
+		return x.InviterName
+	}
+	return ""
+}
+
+func (x *Invite) GetCreatedAt() string {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return ""
+}
+
+func (x *Invite) GetExpiresAt() string {
+	if x != nil {
+		return x.ExpiresAt
+	}
+	return ""
+}
+
+// Request to get pending invites
+type GetInvitesRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetInvitesRequest) Reset() {
+	*x = GetInvitesRequest{}
+	mi := &file_proto_room_room_proto_msgTypes[15]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetInvitesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetInvitesRequest) ProtoMessage() {}
+
+func (x *GetInvitesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_room_room_proto_msgTypes[15]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetInvitesRequest.ProtoReflect.Descriptor instead.
+func (*GetInvitesRequest) Descriptor() ([]byte, []int) {
+	return file_proto_room_room_proto_rawDescGZIP(), []int{15}
+}
+
+// Response to a get invites request
+type GetInvitesResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Invites       []*Invite              `protobuf:"bytes,1,rep,name=invites,proto3" json:"invites,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetInvitesResponse) Reset() {
+	*x = GetInvitesResponse{}
+	mi := &file_proto_room_room_proto_msgTypes[16]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetInvitesResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetInvitesResponse) ProtoMessage() {}
+
+func (x *GetInvitesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_room_room_proto_msgTypes[16]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetInvitesResponse.ProtoReflect.Descriptor instead.
+func (*GetInvitesResponse) Descriptor() ([]byte, []int) {
+	return file_proto_room_room_proto_rawDescGZIP(), []int{16}
+}
+
+func (x *GetInvitesResponse) GetInvites() []*Invite {
+	if x != nil {
+		return x.Invites
+	}
+	return nil
+}
+
+// Request to accept an invite
+type AcceptInviteRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	InviteId      int64                  `protobuf:"varint,1,opt,name=invite_id,json=inviteId,proto3" json:"invite_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *AcceptInviteRequest) Reset() {
+	*x = AcceptInviteRequest{}
+	mi := &file_proto_room_room_proto_msgTypes[17]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AcceptInviteRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AcceptInviteRequest) ProtoMessage() {}
+
+func (x *AcceptInviteRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_room_room_proto_msgTypes[17]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AcceptInviteRequest.ProtoReflect.Descriptor instead.
+func (*AcceptInviteRequest) Descriptor() ([]byte, []int) {
+	return file_proto_room_room_proto_rawDescGZIP(), []int{17}
+}
+
+func (x *AcceptInviteRequest) GetInviteId() int64 {
+	if x != nil {
+		return x.InviteId
+	}
+	return 0
+}
+
+// Response to an accept invite request
+type AcceptInviteResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Success       bool                   `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
+	Message       string                 `protobuf:"bytes,2,opt,name=message,proto3" json:"message,omitempty"`
+	RoomId        int64                  `protobuf:"varint,3,opt,name=room_id,json=roomId,proto3" json:"room_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *AcceptInviteResponse) Reset() {
+	*x = AcceptInviteResponse{}
+	mi := &file_proto_room_room_proto_msgTypes[18]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AcceptInviteResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AcceptInviteResponse) ProtoMessage() {}
+
+func (x *AcceptInviteResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_room_room_proto_msgTypes[18]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AcceptInviteResponse.ProtoReflect.Descriptor instead.
+func (*AcceptInviteResponse) Descriptor() ([]byte, []int) {
+	return file_proto_room_room_proto_rawDescGZIP(), []int{18}
+}
+
+func (x *AcceptInviteResponse) GetSuccess() bool {
+	if x != nil {
+		return x.Success
+	}
+	return false
+}
+
+func (x *AcceptInviteResponse) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+func (x *AcceptInviteResponse) GetRoomId() int64 {
+	if x != nil {
+		return x.RoomId
+	}
+	return 0
+}
+
+// Request to decline an invite
+type DeclineInviteRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	InviteId      int64                  `protobuf:"varint,1,opt,name=invite_id,json=inviteId,proto3" json:"invite_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeclineInviteRequest) Reset() {
+	*x = DeclineInviteRequest{}
+	mi := &file_proto_room_room_proto_msgTypes[19]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeclineInviteRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeclineInviteRequest) ProtoMessage() {}
+
+func (x *DeclineInviteRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_room_room_proto_msgTypes[19]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeclineInviteRequest.ProtoReflect.Descriptor instead.
+func (*DeclineInviteRequest) Descriptor() ([]byte, []int) {
+	return file_proto_room_room_proto_rawDescGZIP(), []int{19}
+}
+
+func (x *DeclineInviteRequest) GetInviteId() int64 {
+	if x != nil {
+		return x.InviteId
+	}
+	return 0
+}
+
+// Response to a decline invite request
+type DeclineInviteResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Success       bool                   `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
+	Message       string                 `protobuf:"bytes,2,opt,name=message,proto3" json:"message,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeclineInviteResponse) Reset() {
+	*x = DeclineInviteResponse{}
+	mi := &file_proto_room_room_proto_msgTypes[20]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeclineInviteResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeclineInviteResponse) ProtoMessage() {}
+
+func (x *DeclineInviteResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_room_room_proto_msgTypes[20]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeclineInviteResponse.ProtoReflect.Descriptor instead.
+func (*DeclineInviteResponse) Descriptor() ([]byte, []int) {
+	return file_proto_room_room_proto_rawDescGZIP(), []int{20}
+}
+
+func (x *DeclineInviteResponse) GetSuccess() bool {
+	if x != nil {
+		return x.Success
+	}
+	return false
+}
+
+func (x *DeclineInviteResponse) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+// Request to create an invite code
+type CreateInviteCodeRequest struct {
+	state  protoimpl.MessageState `protogen:"open.v1"`
+	RoomId int64                  `protobuf:"varint,1,opt,name=room_id,json=roomId,proto3" json:"room_id,omitempty"`
+	// Maximum number of times the code can be used, or 0 for no limit
+	MaxUses int64 `protobuf:"varint,2,opt,name=max_uses,json=maxUses,proto3" json:"max_uses,omitempty"`
+	// Seconds until the code expires, or 0 if it never expires
+	ExpiresIn int64 `protobuf:"varint,3,opt,name=expires_in,json=expiresIn,proto3" json:"expires_in,omitempty"`
+	// Role granted to users who join with the code. Defaults to "member".
+	Role          string `protobuf:"bytes,4,opt,name=role,proto3" json:"role,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreateInviteCodeRequest) Reset() {
+	*x = CreateInviteCodeRequest{}
+	mi := &file_proto_room_room_proto_msgTypes[21]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateInviteCodeRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateInviteCodeRequest) ProtoMessage() {}
+
+func (x *CreateInviteCodeRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_room_room_proto_msgTypes[21]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateInviteCodeRequest.ProtoReflect.Descriptor instead.
+func (*CreateInviteCodeRequest) Descriptor() ([]byte, []int) {
+	return file_proto_room_room_proto_rawDescGZIP(), []int{21}
+}
+
+func (x *CreateInviteCodeRequest) GetRoomId() int64 {
+	if x != nil {
+		return x.RoomId
+	}
+	return 0
+}
+
+func (x *CreateInviteCodeRequest) GetMaxUses() int64 {
+	if x != nil {
+		return x.MaxUses
+	}
+	return 0
+}
+
+func (x *CreateInviteCodeRequest) GetExpiresIn() int64 {
+	if x != nil {
+		return x.ExpiresIn
+	}
+	return 0
+}
+
+func (x *CreateInviteCodeRequest) GetRole() string {
+	if x != nil {
+		return x.Role
+	}
+	return ""
+}
+
+// User who joined a room with an invite code
+type InviteCodeUse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	UserId        int64                  `protobuf:"varint,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	Username      string                 `protobuf:"bytes,2,opt,name=username,proto3" json:"username,omitempty"`
+	UsedAt        string                 `protobuf:"bytes,3,opt,name=used_at,json=usedAt,proto3" json:"used_at,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *InviteCodeUse) Reset() {
+	*x = InviteCodeUse{}
+	mi := &file_proto_room_room_proto_msgTypes[22]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *InviteCodeUse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*InviteCodeUse) ProtoMessage() {}
+
+func (x *InviteCodeUse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_room_room_proto_msgTypes[22]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use InviteCodeUse.ProtoReflect.Descriptor instead.
+func (*InviteCodeUse) Descriptor() ([]byte, []int) {
+	return file_proto_room_room_proto_rawDescGZIP(), []int{22}
+}
+
+func (x *InviteCodeUse) GetUserId() int64 {
+	if x != nil {
+		return x.UserId
+	}
+	return 0
+}
+
+func (x *InviteCodeUse) GetUsername() string {
+	if x != nil {
+		return x.Username
+	}
+	return ""
+}
+
+func (x *InviteCodeUse) GetUsedAt() string {
+	if x != nil {
+		return x.UsedAt
+	}
+	return ""
+}
+
+// Shareable room invite code
+type InviteCode struct {
+	state     protoimpl.MessageState `protogen:"open.v1"`
+	Id        int64                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	Code      string                 `protobuf:"bytes,2,opt,name=code,proto3" json:"code,omitempty"`
+	RoomId    int64                  `protobuf:"varint,3,opt,name=room_id,json=roomId,proto3" json:"room_id,omitempty"`
+	CreatorId int64                  `protobuf:"varint,4,opt,name=creator_id,json=creatorId,proto3" json:"creator_id,omitempty"`
+	Role      string                 `protobuf:"bytes,5,opt,name=role,proto3" json:"role,omitempty"`
+	MaxUses   int64                  `protobuf:"varint,6,opt,name=max_uses,json=maxUses,proto3" json:"max_uses,omitempty"`
+	Uses      int64                  `protobuf:"varint,7,opt,name=uses,proto3" json:"uses,omitempty"`
+	// Empty if the code never expires
+	ExpiresAt     string           `protobuf:"bytes,8,opt,name=expires_at,json=expiresAt,proto3" json:"expires_at,omitempty"`
+	Revoked       bool             `protobuf:"varint,9,opt,name=revoked,proto3" json:"revoked,omitempty"`
+	CreatedAt     string           `protobuf:"bytes,10,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	UsedBy        []*InviteCodeUse `protobuf:"bytes,11,rep,name=used_by,json=usedBy,proto3" json:"used_by,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *InviteCode) Reset() {
+	*x = InviteCode{}
+	mi := &file_proto_room_room_proto_msgTypes[23]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *InviteCode) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*InviteCode) ProtoMessage() {}
+
+func (x *InviteCode) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_room_room_proto_msgTypes[23]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use InviteCode.ProtoReflect.Descriptor instead.
+func (*InviteCode) Descriptor() ([]byte, []int) {
+	return file_proto_room_room_proto_rawDescGZIP(), []int{23}
+}
+
+func (x *InviteCode) GetId() int64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *InviteCode) GetCode() string {
+	if x != nil {
+		return x.Code
+	}
+	return ""
+}
+
+func (x *InviteCode) GetRoomId() int64 {
+	if x != nil {
+		return x.RoomId
+	}
+	return 0
+}
+
+func (x *InviteCode) GetCreatorId() int64 {
+	if x != nil {
+		return x.CreatorId
+	}
+	return 0
+}
+
+func (x *InviteCode) GetRole() string {
+	if x != nil {
+		return x.Role
+	}
+	return ""
+}
+
+func (x *InviteCode) GetMaxUses() int64 {
+	if x != nil {
+		return x.MaxUses
+	}
+	return 0
+}
+
+func (x *InviteCode) GetUses() int64 {
+	if x != nil {
+		return x.Uses
+	}
+	return 0
+}
+
+func (x *InviteCode) GetExpiresAt() string {
+	if x != nil {
+		return x.ExpiresAt
+	}
+	return ""
+}
+
+func (x *InviteCode) GetRevoked() bool {
+	if x != nil {
+		return x.Revoked
+	}
+	return false
+}
+
+func (x *InviteCode) GetCreatedAt() string {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return ""
+}
+
+func (x *InviteCode) GetUsedBy() []*InviteCodeUse {
+	if x != nil {
+		return x.UsedBy
+	}
+	return nil
+}
+
+// Request to list the invite codes of a room
+type ListInviteCodesRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	RoomId        int64                  `protobuf:"varint,1,opt,name=room_id,json=roomId,proto3" json:"room_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListInviteCodesRequest) Reset() {
+	*x = ListInviteCodesRequest{}
+	mi := &file_proto_room_room_proto_msgTypes[24]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListInviteCodesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListInviteCodesRequest) ProtoMessage() {}
+
+func (x *ListInviteCodesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_room_room_proto_msgTypes[24]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListInviteCodesRequest.ProtoReflect.Descriptor instead.
+func (*ListInviteCodesRequest) Descriptor() ([]byte, []int) {
+	return file_proto_room_room_proto_rawDescGZIP(), []int{24}
+}
+
+func (x *ListInviteCodesRequest) GetRoomId() int64 {
+	if x != nil {
+		return x.RoomId
+	}
+	return 0
+}
+
+// Response to a list invite codes request
+type ListInviteCodesResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Codes         []*InviteCode          `protobuf:"bytes,1,rep,name=codes,proto3" json:"codes,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListInviteCodesResponse) Reset() {
+	*x = ListInviteCodesResponse{}
+	mi := &file_proto_room_room_proto_msgTypes[25]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListInviteCodesResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListInviteCodesResponse) ProtoMessage() {}
+
+func (x *ListInviteCodesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_room_room_proto_msgTypes[25]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListInviteCodesResponse.ProtoReflect.Descriptor instead.
+func (*ListInviteCodesResponse) Descriptor() ([]byte, []int) {
+	return file_proto_room_room_proto_rawDescGZIP(), []int{25}
+}
+
+func (x *ListInviteCodesResponse) GetCodes() []*InviteCode {
+	if x != nil {
+		return x.Codes
+	}
+	return nil
+}
+
+// Request to revoke an invite code
+type RevokeInviteCodeRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	RoomId        int64                  `protobuf:"varint,1,opt,name=room_id,json=roomId,proto3" json:"room_id,omitempty"`
+	Code          string                 `protobuf:"bytes,2,opt,name=code,proto3" json:"code,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RevokeInviteCodeRequest) Reset() {
+	*x = RevokeInviteCodeRequest{}
+	mi := &file_proto_room_room_proto_msgTypes[26]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RevokeInviteCodeRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RevokeInviteCodeRequest) ProtoMessage() {}
+
+func (x *RevokeInviteCodeRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_room_room_proto_msgTypes[26]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RevokeInviteCodeRequest.ProtoReflect.Descriptor instead.
+func (*RevokeInviteCodeRequest) Descriptor() ([]byte, []int) {
+	return file_proto_room_room_proto_rawDescGZIP(), []int{26}
+}
+
+func (x *RevokeInviteCodeRequest) GetRoomId() int64 {
+	if x != nil {
+		return x.RoomId
+	}
+	return 0
+}
+
+func (x *RevokeInviteCodeRequest) GetCode() string {
+	if x != nil {
+		return x.Code
+	}
+	return ""
+}
+
+// Response to a revoke invite code request
+type RevokeInviteCodeResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Success       bool                   `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
+	Message       string                 `protobuf:"bytes,2,opt,name=message,proto3" json:"message,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RevokeInviteCodeResponse) Reset() {
+	*x = RevokeInviteCodeResponse{}
+	mi := &file_proto_room_room_proto_msgTypes[27]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RevokeInviteCodeResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RevokeInviteCodeResponse) ProtoMessage() {}
+
+func (x *RevokeInviteCodeResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_room_room_proto_msgTypes[27]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RevokeInviteCodeResponse.ProtoReflect.Descriptor instead.
+func (*RevokeInviteCodeResponse) Descriptor() ([]byte, []int) {
+	return file_proto_room_room_proto_rawDescGZIP(), []int{27}
+}
+
+func (x *RevokeInviteCodeResponse) GetSuccess() bool {
+	if x != nil {
+		return x.Success
+	}
+	return false
+}
+
+func (x *RevokeInviteCodeResponse) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+// Request to join a room with an invite code
+type JoinRoomByInviteRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Code          string                 `protobuf:"bytes,1,opt,name=code,proto3" json:"code,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *JoinRoomByInviteRequest) Reset() {
+	*x = JoinRoomByInviteRequest{}
+	mi := &file_proto_room_room_proto_msgTypes[28]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *JoinRoomByInviteRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*JoinRoomByInviteRequest) ProtoMessage() {}
+
+func (x *JoinRoomByInviteRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_room_room_proto_msgTypes[28]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use JoinRoomByInviteRequest.ProtoReflect.Descriptor instead.
+func (*JoinRoomByInviteRequest) Descriptor() ([]byte, []int) {
+	return file_proto_room_room_proto_rawDescGZIP(), []int{28}
+}
+
+func (x *JoinRoomByInviteRequest) GetCode() string {
+	if x != nil {
+		return x.Code
+	}
+	return ""
+}
+
+// Response to a join room by invite request
+type JoinRoomByInviteResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Success       bool                   `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
+	Message       string                 `protobuf:"bytes,2,opt,name=message,proto3" json:"message,omitempty"`
+	RoomId        int64                  `protobuf:"varint,3,opt,name=room_id,json=roomId,proto3" json:"room_id,omitempty"`
+	Role          string                 `protobuf:"bytes,4,opt,name=role,proto3" json:"role,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *JoinRoomByInviteResponse) Reset() {
+	*x = JoinRoomByInviteResponse{}
+	mi := &file_proto_room_room_proto_msgTypes[29]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *JoinRoomByInviteResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*JoinRoomByInviteResponse) ProtoMessage() {}
+
+func (x *JoinRoomByInviteResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_room_room_proto_msgTypes[29]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use JoinRoomByInviteResponse.ProtoReflect.Descriptor instead.
+func (*JoinRoomByInviteResponse) Descriptor() ([]byte, []int) {
+	return file_proto_room_room_proto_rawDescGZIP(), []int{29}
+}
+
+func (x *JoinRoomByInviteResponse) GetSuccess() bool {
+	if x != nil {
+		return x.Success
+	}
+	return false
+}
+
+func (x *JoinRoomByInviteResponse) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+func (x *JoinRoomByInviteResponse) GetRoomId() int64 {
+	if x != nil {
+		return x.RoomId
+	}
+	return 0
+}
+
+func (x *JoinRoomByInviteResponse) GetRole() string {
+	if x != nil {
+		return x.Role
+	}
+	return ""
+}
+
+// Request to kick a member from a room
+type KickMemberRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	RoomId        int64                  `protobuf:"varint,1,opt,name=room_id,json=roomId,proto3" json:"room_id,omitempty"`
+	UserId        int64                  `protobuf:"varint,2,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *KickMemberRequest) Reset() {
+	*x = KickMemberRequest{}
+	mi := &file_proto_room_room_proto_msgTypes[30]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *KickMemberRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*KickMemberRequest) ProtoMessage() {}
+
+func (x *KickMemberRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_room_room_proto_msgTypes[30]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use KickMemberRequest.ProtoReflect.Descriptor instead.
+func (*KickMemberRequest) Descriptor() ([]byte, []int) {
+	return file_proto_room_room_proto_rawDescGZIP(), []int{30}
+}
+
+func (x *KickMemberRequest) GetRoomId() int64 {
+	if x != nil {
+		return x.RoomId
+	}
+	return 0
+}
+
+func (x *KickMemberRequest) GetUserId() int64 {
+	if x != nil {
+		return x.UserId
+	}
+	return 0
+}
+
+// Response to a kick member request
+type KickMemberResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Success       bool                   `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
+	Message       string                 `protobuf:"bytes,2,opt,name=message,proto3" json:"message,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *KickMemberResponse) Reset() {
+	*x = KickMemberResponse{}
+	mi := &file_proto_room_room_proto_msgTypes[31]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *KickMemberResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*KickMemberResponse) ProtoMessage() {}
+
+func (x *KickMemberResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_room_room_proto_msgTypes[31]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use KickMemberResponse.ProtoReflect.Descriptor instead.
+func (*KickMemberResponse) Descriptor() ([]byte, []int) {
+	return file_proto_room_room_proto_rawDescGZIP(), []int{31}
+}
+
+func (x *KickMemberResponse) GetSuccess() bool {
+	if x != nil {
+		return x.Success
+	}
+	return false
+}
+
+func (x *KickMemberResponse) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+// Request to ban a user from a room
+type BanMemberRequest struct {
+	state  protoimpl.MessageState `protogen:"open.v1"`
+	RoomId int64                  `protobuf:"varint,1,opt,name=room_id,json=roomId,proto3" json:"room_id,omitempty"`
+	UserId int64                  `protobuf:"varint,2,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	// Seconds until the ban is lifted, or 0 for a permanent ban
+	Duration      int64  `protobuf:"varint,3,opt,name=duration,proto3" json:"duration,omitempty"`
+	Reason        string `protobuf:"bytes,4,opt,name=reason,proto3" json:"reason,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *BanMemberRequest) Reset() {
+	*x = BanMemberRequest{}
+	mi := &file_proto_room_room_proto_msgTypes[32]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *BanMemberRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BanMemberRequest) ProtoMessage() {}
+
+func (x *BanMemberRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_room_room_proto_msgTypes[32]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BanMemberRequest.ProtoReflect.Descriptor instead.
+func (*BanMemberRequest) Descriptor() ([]byte, []int) {
+	return file_proto_room_room_proto_rawDescGZIP(), []int{32}
+}
+
+func (x *BanMemberRequest) GetRoomId() int64 {
+	if x != nil {
+		return x.RoomId
+	}
+	return 0
+}
+
+func (x *BanMemberRequest) GetUserId() int64 {
+	if x != nil {
+		return x.UserId
+	}
+	return 0
+}
+
+func (x *BanMemberRequest) GetDuration() int64 {
+	if x != nil {
+		return x.Duration
+	}
+	return 0
+}
+
+func (x *BanMemberRequest) GetReason() string {
+	if x != nil {
+		return x.Reason
+	}
+	return ""
+}
+
+// Response to a ban member request
+type BanMemberResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Success       bool                   `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
+	Message       string                 `protobuf:"bytes,2,opt,name=message,proto3" json:"message,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *BanMemberResponse) Reset() {
+	*x = BanMemberResponse{}
+	mi := &file_proto_room_room_proto_msgTypes[33]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *BanMemberResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BanMemberResponse) ProtoMessage() {}
+
+func (x *BanMemberResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_room_room_proto_msgTypes[33]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BanMemberResponse.ProtoReflect.Descriptor instead.
+func (*BanMemberResponse) Descriptor() ([]byte, []int) {
+	return file_proto_room_room_proto_rawDescGZIP(), []int{33}
+}
+
+func (x *BanMemberResponse) GetSuccess() bool {
+	if x != nil {
+		return x.Success
+	}
+	return false
+}
+
+func (x *BanMemberResponse) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+// Request to unban a user from a room
+type UnbanMemberRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	RoomId        int64                  `protobuf:"varint,1,opt,name=room_id,json=roomId,proto3" json:"room_id,omitempty"`
+	UserId        int64                  `protobuf:"varint,2,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UnbanMemberRequest) Reset() {
+	*x = UnbanMemberRequest{}
+	mi := &file_proto_room_room_proto_msgTypes[34]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UnbanMemberRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UnbanMemberRequest) ProtoMessage() {}
+
+func (x *UnbanMemberRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_room_room_proto_msgTypes[34]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UnbanMemberRequest.ProtoReflect.Descriptor instead.
+func (*UnbanMemberRequest) Descriptor() ([]byte, []int) {
+	return file_proto_room_room_proto_rawDescGZIP(), []int{34}
+}
+
+func (x *UnbanMemberRequest) GetRoomId() int64 {
+	if x != nil {
+		return x.RoomId
+	}
+	return 0
+}
+
+func (x *UnbanMemberRequest) GetUserId() int64 {
+	if x != nil {
+		return x.UserId
+	}
+	return 0
+}
+
+// Response to an unban member request
+type UnbanMemberResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Success       bool                   `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
+	Message       string                 `protobuf:"bytes,2,opt,name=message,proto3" json:"message,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UnbanMemberResponse) Reset() {
+	*x = UnbanMemberResponse{}
+	mi := &file_proto_room_room_proto_msgTypes[35]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UnbanMemberResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UnbanMemberResponse) ProtoMessage() {}
+
+func (x *UnbanMemberResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_room_room_proto_msgTypes[35]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UnbanMemberResponse.ProtoReflect.Descriptor instead.
+func (*UnbanMemberResponse) Descriptor() ([]byte, []int) {
+	return file_proto_room_room_proto_rawDescGZIP(), []int{35}
+}
+
+func (x *UnbanMemberResponse) GetSuccess() bool {
+	if x != nil {
+		return x.Success
+	}
+	return false
+}
+
+func (x *UnbanMemberResponse) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+// Request to mute a member of a room
+type MuteMemberRequest struct {
+	state  protoimpl.MessageState `protogen:"open.v1"`
+	RoomId int64                  `protobuf:"varint,1,opt,name=room_id,json=roomId,proto3" json:"room_id,omitempty"`
+	UserId int64                  `protobuf:"varint,2,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	// Seconds until the mute is lifted, or 0 for an indefinite mute
+	Duration      int64  `protobuf:"varint,3,opt,name=duration,proto3" json:"duration,omitempty"`
+	Reason        string `protobuf:"bytes,4,opt,name=reason,proto3" json:"reason,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *MuteMemberRequest) Reset() {
+	*x = MuteMemberRequest{}
+	mi := &file_proto_room_room_proto_msgTypes[36]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *MuteMemberRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MuteMemberRequest) ProtoMessage() {}
+
+func (x *MuteMemberRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_room_room_proto_msgTypes[36]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MuteMemberRequest.ProtoReflect.Descriptor instead.
+func (*MuteMemberRequest) Descriptor() ([]byte, []int) {
+	return file_proto_room_room_proto_rawDescGZIP(), []int{36}
+}
+
+func (x *MuteMemberRequest) GetRoomId() int64 {
+	if x != nil {
+		return x.RoomId
+	}
+	return 0
+}
+
+func (x *MuteMemberRequest) GetUserId() int64 {
+	if x != nil {
+		return x.UserId
+	}
+	return 0
+}
+
+func (x *MuteMemberRequest) GetDuration() int64 {
+	if x != nil {
+		return x.Duration
+	}
+	return 0
+}
+
+func (x *MuteMemberRequest) GetReason() string {
+	if x != nil {
+		return x.Reason
+	}
+	return ""
+}
+
+// Response to a mute member request
+type MuteMemberResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Success       bool                   `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
+	Message       string                 `protobuf:"bytes,2,opt,name=message,proto3" json:"message,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *MuteMemberResponse) Reset() {
+	*x = MuteMemberResponse{}
+	mi := &file_proto_room_room_proto_msgTypes[37]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *MuteMemberResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MuteMemberResponse) ProtoMessage() {}
+
+func (x *MuteMemberResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_room_room_proto_msgTypes[37]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MuteMemberResponse.ProtoReflect.Descriptor instead.
+func (*MuteMemberResponse) Descriptor() ([]byte, []int) {
+	return file_proto_room_room_proto_rawDescGZIP(), []int{37}
+}
+
+func (x *MuteMemberResponse) GetSuccess() bool {
+	if x != nil {
+		return x.Success
+	}
+	return false
+}
+
+func (x *MuteMemberResponse) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+// Request to unmute a member of a room
+type UnmuteMemberRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	RoomId        int64                  `protobuf:"varint,1,opt,name=room_id,json=roomId,proto3" json:"room_id,omitempty"`
+	UserId        int64                  `protobuf:"varint,2,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UnmuteMemberRequest) Reset() {
+	*x = UnmuteMemberRequest{}
+	mi := &file_proto_room_room_proto_msgTypes[38]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UnmuteMemberRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UnmuteMemberRequest) ProtoMessage() {}
+
+func (x *UnmuteMemberRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_room_room_proto_msgTypes[38]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UnmuteMemberRequest.ProtoReflect.Descriptor instead.
+func (*UnmuteMemberRequest) Descriptor() ([]byte, []int) {
+	return file_proto_room_room_proto_rawDescGZIP(), []int{38}
+}
+
+func (x *UnmuteMemberRequest) GetRoomId() int64 {
+	if x != nil {
+		return x.RoomId
+	}
+	return 0
+}
+
+func (x *UnmuteMemberRequest) GetUserId() int64 {
+	if x != nil {
+		return x.UserId
+	}
+	return 0
+}
+
+// Response to an unmute member request
+type UnmuteMemberResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Success       bool                   `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
+	Message       string                 `protobuf:"bytes,2,opt,name=message,proto3" json:"message,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UnmuteMemberResponse) Reset() {
+	*x = UnmuteMemberResponse{}
+	mi := &file_proto_room_room_proto_msgTypes[39]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UnmuteMemberResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UnmuteMemberResponse) ProtoMessage() {}
+
+func (x *UnmuteMemberResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_room_room_proto_msgTypes[39]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UnmuteMemberResponse.ProtoReflect.Descriptor instead.
+func (*UnmuteMemberResponse) Descriptor() ([]byte, []int) {
+	return file_proto_room_room_proto_rawDescGZIP(), []int{39}
+}
+
+func (x *UnmuteMemberResponse) GetSuccess() bool {
+	if x != nil {
+		return x.Success
+	}
+	return false
+}
+
+func (x *UnmuteMemberResponse) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+// Request to update a room. Fields that are not set are left unchanged.
+type UpdateRoomRequest struct {
+	state       protoimpl.MessageState `protogen:"open.v1"`
+	RoomId      int64                  `protobuf:"varint,1,opt,name=room_id,json=roomId,proto3" json:"room_id,omitempty"`
+	Name        *string                `protobuf:"bytes,2,opt,name=name,proto3,oneof" json:"name,omitempty"`
+	Description *string                `protobuf:"bytes,3,opt,name=description,proto3,oneof" json:"description,omitempty"`
+	Topic       *string                `protobuf:"bytes,4,opt,name=topic,proto3,oneof" json:"topic,omitempty"`
+	AvatarUrl   *string                `protobuf:"bytes,5,opt,name=avatar_url,json=avatarUrl,proto3,oneof" json:"avatar_url,omitempty"`
+	// 0 removes the limit
+	MaxMembers    *int64  `protobuf:"varint,6,opt,name=max_members,json=maxMembers,proto3,oneof" json:"max_members,omitempty"`
+	JoinPolicy    *string `protobuf:"bytes,7,opt,name=join_policy,json=joinPolicy,proto3,oneof" json:"join_policy,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UpdateRoomRequest) Reset() {
+	*x = UpdateRoomRequest{}
+	mi := &file_proto_room_room_proto_msgTypes[40]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdateRoomRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateRoomRequest) ProtoMessage() {}
+
+func (x *UpdateRoomRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_room_room_proto_msgTypes[40]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateRoomRequest.ProtoReflect.Descriptor instead.
+func (*UpdateRoomRequest) Descriptor() ([]byte, []int) {
+	return file_proto_room_room_proto_rawDescGZIP(), []int{40}
+}
+
+func (x *UpdateRoomRequest) GetRoomId() int64 {
+	if x != nil {
+		return x.RoomId
+	}
+	return 0
+}
+
+func (x *UpdateRoomRequest) GetName() string {
+	if x != nil && x.Name != nil {
+		return *x.Name
+	}
+	return ""
+}
+
+func (x *UpdateRoomRequest) GetDescription() string {
+	if x != nil && x.Description != nil {
+		return *x.Description
+	}
+	return ""
+}
+
+func (x *UpdateRoomRequest) GetTopic() string {
+	if x != nil && x.Topic != nil {
+		return *x.Topic
+	}
+	return ""
+}
+
+func (x *UpdateRoomRequest) GetAvatarUrl() string {
+	if x != nil && x.AvatarUrl != nil {
+		return *x.AvatarUrl
+	}
+	return ""
+}
+
+func (x *UpdateRoomRequest) GetMaxMembers() int64 {
+	if x != nil && x.MaxMembers != nil {
+		return *x.MaxMembers
+	}
+	return 0
+}
+
+func (x *UpdateRoomRequest) GetJoinPolicy() string {
+	if x != nil && x.JoinPolicy != nil {
+		return *x.JoinPolicy
+	}
+	return ""
+}
+
+// Request to archive a room
+type ArchiveRoomRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	RoomId        int64                  `protobuf:"varint,1,opt,name=room_id,json=roomId,proto3" json:"room_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ArchiveRoomRequest) Reset() {
+	*x = ArchiveRoomRequest{}
+	mi := &file_proto_room_room_proto_msgTypes[41]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ArchiveRoomRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ArchiveRoomRequest) ProtoMessage() {}
+
+func (x *ArchiveRoomRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_room_room_proto_msgTypes[41]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ArchiveRoomRequest.ProtoReflect.Descriptor instead.
+func (*ArchiveRoomRequest) Descriptor() ([]byte, []int) {
+	return file_proto_room_room_proto_rawDescGZIP(), []int{41}
+}
+
+func (x *ArchiveRoomRequest) GetRoomId() int64 {
+	if x != nil {
+		return x.RoomId
+	}
+	return 0
+}
+
+// Response to an archive room request
+type ArchiveRoomResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Success       bool                   `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
+	Message       string                 `protobuf:"bytes,2,opt,name=message,proto3" json:"message,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ArchiveRoomResponse) Reset() {
+	*x = ArchiveRoomResponse{}
+	mi := &file_proto_room_room_proto_msgTypes[42]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ArchiveRoomResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ArchiveRoomResponse) ProtoMessage() {}
+
+func (x *ArchiveRoomResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_room_room_proto_msgTypes[42]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ArchiveRoomResponse.ProtoReflect.Descriptor instead.
+func (*ArchiveRoomResponse) Descriptor() ([]byte, []int) {
+	return file_proto_room_room_proto_rawDescGZIP(), []int{42}
+}
+
+func (x *ArchiveRoomResponse) GetSuccess() bool {
+	if x != nil {
+		return x.Success
+	}
+	return false
+}
+
+func (x *ArchiveRoomResponse) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+// Request to unarchive a room
+type UnarchiveRoomRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	RoomId        int64                  `protobuf:"varint,1,opt,name=room_id,json=roomId,proto3" json:"room_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UnarchiveRoomRequest) Reset() {
+	*x = UnarchiveRoomRequest{}
+	mi := &file_proto_room_room_proto_msgTypes[43]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UnarchiveRoomRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UnarchiveRoomRequest) ProtoMessage() {}
+
+func (x *UnarchiveRoomRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_room_room_proto_msgTypes[43]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UnarchiveRoomRequest.ProtoReflect.Descriptor instead.
+func (*UnarchiveRoomRequest) Descriptor() ([]byte, []int) {
+	return file_proto_room_room_proto_rawDescGZIP(), []int{43}
+}
+
+func (x *UnarchiveRoomRequest) GetRoomId() int64 {
+	if x != nil {
+		return x.RoomId
+	}
+	return 0
+}
+
+// Response to an unarchive room request
+type UnarchiveRoomResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Success       bool                   `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
+	Message       string                 `protobuf:"bytes,2,opt,name=message,proto3" json:"message,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UnarchiveRoomResponse) Reset() {
+	*x = UnarchiveRoomResponse{}
+	mi := &file_proto_room_room_proto_msgTypes[44]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UnarchiveRoomResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UnarchiveRoomResponse) ProtoMessage() {}
+
+func (x *UnarchiveRoomResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_room_room_proto_msgTypes[44]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UnarchiveRoomResponse.ProtoReflect.Descriptor instead.
+func (*UnarchiveRoomResponse) Descriptor() ([]byte, []int) {
+	return file_proto_room_room_proto_rawDescGZIP(), []int{44}
+}
+
+func (x *UnarchiveRoomResponse) GetSuccess() bool {
+	if x != nil {
+		return x.Success
+	}
+	return false
+}
+
+func (x *UnarchiveRoomResponse) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+// Request to delete a room
+type DeleteRoomRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	RoomId        int64                  `protobuf:"varint,1,opt,name=room_id,json=roomId,proto3" json:"room_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeleteRoomRequest) Reset() {
+	*x = DeleteRoomRequest{}
+	mi := &file_proto_room_room_proto_msgTypes[45]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteRoomRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteRoomRequest) ProtoMessage() {}
+
+func (x *DeleteRoomRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_room_room_proto_msgTypes[45]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteRoomRequest.ProtoReflect.Descriptor instead.
+func (*DeleteRoomRequest) Descriptor() ([]byte, []int) {
+	return file_proto_room_room_proto_rawDescGZIP(), []int{45}
+}
+
+func (x *DeleteRoomRequest) GetRoomId() int64 {
+	if x != nil {
+		return x.RoomId
+	}
+	return 0
+}
+
+// Response to a delete room request
+type DeleteRoomResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Success       bool                   `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
+	Message       string                 `protobuf:"bytes,2,opt,name=message,proto3" json:"message,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeleteRoomResponse) Reset() {
+	*x = DeleteRoomResponse{}
+	mi := &file_proto_room_room_proto_msgTypes[46]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteRoomResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteRoomResponse) ProtoMessage() {}
+
+func (x *DeleteRoomResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_room_room_proto_msgTypes[46]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteRoomResponse.ProtoReflect.Descriptor instead.
+func (*DeleteRoomResponse) Descriptor() ([]byte, []int) {
+	return file_proto_room_room_proto_rawDescGZIP(), []int{46}
+}
+
+func (x *DeleteRoomResponse) GetSuccess() bool {
+	if x != nil {
+		return x.Success
+	}
+	return false
+}
+
+func (x *DeleteRoomResponse) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+// Request to search the public room directory
+type SearchRoomsRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Only list rooms whose name or description contains this string
+	Query string `protobuf:"bytes,1,opt,name=query,proto3" json:"query,omitempty"`
+	// "member_count" (default) or "recent_activity"
+	SortBy   string `protobuf:"bytes,2,opt,name=sort_by,json=sortBy,proto3" json:"sort_by,omitempty"`
+	PageSize int64  `protobuf:"varint,3,opt,name=page_size,json=pageSize,proto3" json:"page_size,omitempty"`
+	// next_cursor of the previous page, or empty for the first page
+	Cursor        string `protobuf:"bytes,4,opt,name=cursor,proto3" json:"cursor,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SearchRoomsRequest) Reset() {
+	*x = SearchRoomsRequest{}
+	mi := &file_proto_room_room_proto_msgTypes[47]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SearchRoomsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SearchRoomsRequest) ProtoMessage() {}
+
+func (x *SearchRoomsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_room_room_proto_msgTypes[47]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SearchRoomsRequest.ProtoReflect.Descriptor instead.
+func (*SearchRoomsRequest) Descriptor() ([]byte, []int) {
+	return file_proto_room_room_proto_rawDescGZIP(), []int{47}
+}
+
+func (x *SearchRoomsRequest) GetQuery() string {
+	if x != nil {
+		return x.Query
+	}
+	return ""
+}
+
+func (x *SearchRoomsRequest) GetSortBy() string {
+	if x != nil {
+		return x.SortBy
+	}
+	return ""
+}
+
+func (x *SearchRoomsRequest) GetPageSize() int64 {
+	if x != nil {
+		return x.PageSize
+	}
+	return 0
+}
+
+func (x *SearchRoomsRequest) GetCursor() string {
+	if x != nil {
+		return x.Cursor
+	}
+	return ""
+}
+
+// Response to a search rooms request. Rooms include member_count and
+// last_activity_at.
+type SearchRoomsResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	Rooms []*RoomResponse        `protobuf:"bytes,1,rep,name=rooms,proto3" json:"rooms,omitempty"`
+	// Empty on the last page
+	NextCursor    string `protobuf:"bytes,2,opt,name=next_cursor,json=nextCursor,proto3" json:"next_cursor,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SearchRoomsResponse) Reset() {
+	*x = SearchRoomsResponse{}
+	mi := &file_proto_room_room_proto_msgTypes[48]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SearchRoomsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SearchRoomsResponse) ProtoMessage() {}
+
+func (x *SearchRoomsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_room_room_proto_msgTypes[48]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SearchRoomsResponse.ProtoReflect.Descriptor instead.
+func (*SearchRoomsResponse) Descriptor() ([]byte, []int) {
+	return file_proto_room_room_proto_rawDescGZIP(), []int{48}
+}
+
+func (x *SearchRoomsResponse) GetRooms() []*RoomResponse {
+	if x != nil {
+		return x.Rooms
+	}
+	return nil
+}
+
+func (x *SearchRoomsResponse) GetNextCursor() string {
+	if x != nil {
+		return x.NextCursor
+	}
+	return ""
+}
+
+// Member of a room
+type RoomMember struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	UserId        int64                  `protobuf:"varint,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	Username      string                 `protobuf:"bytes,2,opt,name=username,proto3" json:"username,omitempty"`
+	Role          string                 `protobuf:"bytes,3,opt,name=role,proto3" json:"role,omitempty"`
+	JoinedAt      string                 `protobuf:"bytes,4,opt,name=joined_at,json=joinedAt,proto3" json:"joined_at,omitempty"`
+	Online        bool                   `protobuf:"varint,5,opt,name=online,proto3" json:"online,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RoomMember) Reset() {
+	*x = RoomMember{}
+	mi := &file_proto_room_room_proto_msgTypes[49]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RoomMember) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RoomMember) ProtoMessage() {}
+
+func (x *RoomMember) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_room_room_proto_msgTypes[49]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RoomMember.ProtoReflect.Descriptor instead.
+func (*RoomMember) Descriptor() ([]byte, []int) {
+	return file_proto_room_room_proto_rawDescGZIP(), []int{49}
+}
+
+func (x *RoomMember) GetUserId() int64 {
+	if x != nil {
+		return x.UserId
+	}
+	return 0
+}
+
+func (x *RoomMember) GetUsername() string {
+	if x != nil {
+		return x.Username
+	}
+	return ""
+}
+
+func (x *RoomMember) GetRole() string {
+	if x != nil {
+		return x.Role
+	}
+	return ""
+}
+
+func (x *RoomMember) GetJoinedAt() string {
+	if x != nil {
+		return x.JoinedAt
+	}
+	return ""
+}
+
+func (x *RoomMember) GetOnline() bool {
+	if x != nil {
+		return x.Online
+	}
+	return false
+}
+
+// Request to get a room
+type GetRoomRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	RoomId        int64                  `protobuf:"varint,1,opt,name=room_id,json=roomId,proto3" json:"room_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetRoomRequest) Reset() {
+	*x = GetRoomRequest{}
+	mi := &file_proto_room_room_proto_msgTypes[50]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetRoomRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetRoomRequest) ProtoMessage() {}
+
+func (x *GetRoomRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_room_room_proto_msgTypes[50]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetRoomRequest.ProtoReflect.Descriptor instead.
+func (*GetRoomRequest) Descriptor() ([]byte, []int) {
+	return file_proto_room_room_proto_rawDescGZIP(), []int{50}
+}
+
+func (x *GetRoomRequest) GetRoomId() int64 {
+	if x != nil {
+		return x.RoomId
+	}
+	return 0
+}
+
+// Response to a get room request
+type GetRoomResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Includes member_count
+	Room *RoomResponse `protobuf:"bytes,1,opt,name=room,proto3" json:"room,omitempty"`
+	// The user's own membership, or unset if they are not a member
+	Membership    *RoomMember `protobuf:"bytes,2,opt,name=membership,proto3" json:"membership,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetRoomResponse) Reset() {
+	*x = GetRoomResponse{}
+	mi := &file_proto_room_room_proto_msgTypes[51]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetRoomResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetRoomResponse) ProtoMessage() {}
+
+func (x *GetRoomResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_room_room_proto_msgTypes[51]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetRoomResponse.ProtoReflect.Descriptor instead.
+func (*GetRoomResponse) Descriptor() ([]byte, []int) {
+	return file_proto_room_room_proto_rawDescGZIP(), []int{51}
+}
+
+func (x *GetRoomResponse) GetRoom() *RoomResponse {
+	if x != nil {
+		return x.Room
+	}
+	return nil
+}
+
+func (x *GetRoomResponse) GetMembership() *RoomMember {
+	if x != nil {
+		return x.Membership
+	}
+	return nil
+}
+
+// Request to list the members of a room
+type ListRoomMembersRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	RoomId        int64                  `protobuf:"varint,1,opt,name=room_id,json=roomId,proto3" json:"room_id,omitempty"`
+	Limit         int64                  `protobuf:"varint,2,opt,name=limit,proto3" json:"limit,omitempty"`
+	Offset        int64                  `protobuf:"varint,3,opt,name=offset,proto3" json:"offset,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListRoomMembersRequest) Reset() {
+	*x = ListRoomMembersRequest{}
+	mi := &file_proto_room_room_proto_msgTypes[52]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListRoomMembersRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListRoomMembersRequest) ProtoMessage() {}
+
+func (x *ListRoomMembersRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_room_room_proto_msgTypes[52]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListRoomMembersRequest.ProtoReflect.Descriptor instead.
+func (*ListRoomMembersRequest) Descriptor() ([]byte, []int) {
+	return file_proto_room_room_proto_rawDescGZIP(), []int{52}
+}
+
+func (x *ListRoomMembersRequest) GetRoomId() int64 {
+	if x != nil {
+		return x.RoomId
+	}
+	return 0
+}
+
+func (x *ListRoomMembersRequest) GetLimit() int64 {
+	if x != nil {
+		return x.Limit
+	}
+	return 0
+}
+
+func (x *ListRoomMembersRequest) GetOffset() int64 {
+	if x != nil {
+		return x.Offset
+	}
+	return 0
+}
+
+// Response to a list room members request
+type ListRoomMembersResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Members       []*RoomMember          `protobuf:"bytes,1,rep,name=members,proto3" json:"members,omitempty"`
+	Total         int64                  `protobuf:"varint,2,opt,name=total,proto3" json:"total,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListRoomMembersResponse) Reset() {
+	*x = ListRoomMembersResponse{}
+	mi := &file_proto_room_room_proto_msgTypes[53]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListRoomMembersResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListRoomMembersResponse) ProtoMessage() {}
+
+func (x *ListRoomMembersResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_room_room_proto_msgTypes[53]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListRoomMembersResponse.ProtoReflect.Descriptor instead.
+func (*ListRoomMembersResponse) Descriptor() ([]byte, []int) {
+	return file_proto_room_room_proto_rawDescGZIP(), []int{53}
+}
+
+func (x *ListRoomMembersResponse) GetMembers() []*RoomMember {
+	if x != nil {
+		return x.Members
+	}
+	return nil
+}
+
+func (x *ListRoomMembersResponse) GetTotal() int64 {
+	if x != nil {
+		return x.Total
+	}
+	return 0
+}
+
+// Request to join a room that requires approval
+type JoinRequest struct {
+	state    protoimpl.MessageState `protogen:"open.v1"`
+	Id       int64                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	RoomId   int64                  `protobuf:"varint,2,opt,name=room_id,json=roomId,proto3" json:"room_id,omitempty"`
+	RoomName string                 `protobuf:"bytes,3,opt,name=room_name,json=roomName,proto3" json:"room_name,omitempty"`
+	UserId   int64                  `protobuf:"varint,4,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	Username string                 `protobuf:"bytes,5,opt,name=username,proto3" json:"username,omitempty"`
+	Message  string                 `protobuf:"bytes,6,opt,name=message,proto3" json:"message,omitempty"`
+	// "pending", "approved" or "rejected"
+	Status    string `protobuf:"bytes,7,opt,name=status,proto3" json:"status,omitempty"`
+	CreatedAt string `protobuf:"bytes,8,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	// Empty while pending
+	DecidedAt     string `protobuf:"bytes,9,opt,name=decided_at,json=decidedAt,proto3" json:"decided_at,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *JoinRequest) Reset() {
+	*x = JoinRequest{}
+	mi := &file_proto_room_room_proto_msgTypes[54]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *JoinRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*JoinRequest) ProtoMessage() {}
+
+func (x *JoinRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_room_room_proto_msgTypes[54]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use JoinRequest.ProtoReflect.Descriptor instead.
+func (*JoinRequest) Descriptor() ([]byte, []int) {
+	return file_proto_room_room_proto_rawDescGZIP(), []int{54}
+}
+
+func (x *JoinRequest) GetId() int64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *JoinRequest) GetRoomId() int64 {
+	if x != nil {
+		return x.RoomId
+	}
+	return 0
+}
+
+func (x *JoinRequest) GetRoomName() string {
+	if x != nil {
+		return x.RoomName
+	}
+	return ""
+}
+
+func (x *JoinRequest) GetUserId() int64 {
+	if x != nil {
+		return x.UserId
+	}
+	return 0
+}
+
+func (x *JoinRequest) GetUsername() string {
+	if x != nil {
+		return x.Username
+	}
+	return ""
+}
+
+func (x *JoinRequest) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+func (x *JoinRequest) GetStatus() string {
+	if x != nil {
+		return x.Status
+	}
+	return ""
+}
+
+func (x *JoinRequest) GetCreatedAt() string {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return ""
+}
+
+func (x *JoinRequest) GetDecidedAt() string {
+	if x != nil {
+		return x.DecidedAt
+	}
+	return ""
+}
+
+// Request to list the pending join requests of a room
+type ListJoinRequestsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	RoomId        int64                  `protobuf:"varint,1,opt,name=room_id,json=roomId,proto3" json:"room_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListJoinRequestsRequest) Reset() {
+	*x = ListJoinRequestsRequest{}
+	mi := &file_proto_room_room_proto_msgTypes[55]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListJoinRequestsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListJoinRequestsRequest) ProtoMessage() {}
+
+func (x *ListJoinRequestsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_room_room_proto_msgTypes[55]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListJoinRequestsRequest.ProtoReflect.Descriptor instead.
+func (*ListJoinRequestsRequest) Descriptor() ([]byte, []int) {
+	return file_proto_room_room_proto_rawDescGZIP(), []int{55}
+}
+
+func (x *ListJoinRequestsRequest) GetRoomId() int64 {
+	if x != nil {
+		return x.RoomId
+	}
+	return 0
+}
+
+// Response to a list join requests request
+type ListJoinRequestsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Requests      []*JoinRequest         `protobuf:"bytes,1,rep,name=requests,proto3" json:"requests,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListJoinRequestsResponse) Reset() {
+	*x = ListJoinRequestsResponse{}
+	mi := &file_proto_room_room_proto_msgTypes[56]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListJoinRequestsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListJoinRequestsResponse) ProtoMessage() {}
+
+func (x *ListJoinRequestsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_room_room_proto_msgTypes[56]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListJoinRequestsResponse.ProtoReflect.Descriptor instead.
+func (*ListJoinRequestsResponse) Descriptor() ([]byte, []int) {
+	return file_proto_room_room_proto_rawDescGZIP(), []int{56}
+}
+
+func (x *ListJoinRequestsResponse) GetRequests() []*JoinRequest {
+	if x != nil {
+		return x.Requests
+	}
+	return nil
+}
+
+// Request to get the user's own join requests
+type GetJoinRequestsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetJoinRequestsRequest) Reset() {
+	*x = GetJoinRequestsRequest{}
+	mi := &file_proto_room_room_proto_msgTypes[57]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetJoinRequestsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetJoinRequestsRequest) ProtoMessage() {}
+
+func (x *GetJoinRequestsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_room_room_proto_msgTypes[57]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetJoinRequestsRequest.ProtoReflect.Descriptor instead.
+func (*GetJoinRequestsRequest) Descriptor() ([]byte, []int) {
+	return file_proto_room_room_proto_rawDescGZIP(), []int{57}
+}
+
+// Response to a get join requests request
+type GetJoinRequestsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Requests      []*JoinRequest         `protobuf:"bytes,1,rep,name=requests,proto3" json:"requests,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetJoinRequestsResponse) Reset() {
+	*x = GetJoinRequestsResponse{}
+	mi := &file_proto_room_room_proto_msgTypes[58]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetJoinRequestsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetJoinRequestsResponse) ProtoMessage() {}
+
+func (x *GetJoinRequestsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_room_room_proto_msgTypes[58]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetJoinRequestsResponse.ProtoReflect.Descriptor instead.
+func (*GetJoinRequestsResponse) Descriptor() ([]byte, []int) {
+	return file_proto_room_room_proto_rawDescGZIP(), []int{58}
+}
+
+func (x *GetJoinRequestsResponse) GetRequests() []*JoinRequest {
+	if x != nil {
+		return x.Requests
+	}
+	return nil
+}
+
+// Request to approve a join request
+type ApproveJoinRequestRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	RequestId     int64                  `protobuf:"varint,1,opt,name=request_id,json=requestId,proto3" json:"request_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ApproveJoinRequestRequest) Reset() {
+	*x = ApproveJoinRequestRequest{}
+	mi := &file_proto_room_room_proto_msgTypes[59]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ApproveJoinRequestRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ApproveJoinRequestRequest) ProtoMessage() {}
+
+func (x *ApproveJoinRequestRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_room_room_proto_msgTypes[59]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ApproveJoinRequestRequest.ProtoReflect.Descriptor instead.
+func (*ApproveJoinRequestRequest) Descriptor() ([]byte, []int) {
+	return file_proto_room_room_proto_rawDescGZIP(), []int{59}
+}
+
+func (x *ApproveJoinRequestRequest) GetRequestId() int64 {
+	if x != nil {
+		return x.RequestId
+	}
+	return 0
+}
+
+// Response to an approve join request request
+type ApproveJoinRequestResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Success       bool                   `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
+	Message       string                 `protobuf:"bytes,2,opt,name=message,proto3" json:"message,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ApproveJoinRequestResponse) Reset() {
+	*x = ApproveJoinRequestResponse{}
+	mi := &file_proto_room_room_proto_msgTypes[60]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ApproveJoinRequestResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ApproveJoinRequestResponse) ProtoMessage() {}
+
+func (x *ApproveJoinRequestResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_room_room_proto_msgTypes[60]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ApproveJoinRequestResponse.ProtoReflect.Descriptor instead.
+func (*ApproveJoinRequestResponse) Descriptor() ([]byte, []int) {
+	return file_proto_room_room_proto_rawDescGZIP(), []int{60}
+}
+
+func (x *ApproveJoinRequestResponse) GetSuccess() bool {
+	if x != nil {
+		return x.Success
+	}
+	return false
+}
+
+func (x *ApproveJoinRequestResponse) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+// Request to reject a join request
+type RejectJoinRequestRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	RequestId     int64                  `protobuf:"varint,1,opt,name=request_id,json=requestId,proto3" json:"request_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RejectJoinRequestRequest) Reset() {
+	*x = RejectJoinRequestRequest{}
+	mi := &file_proto_room_room_proto_msgTypes[61]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RejectJoinRequestRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RejectJoinRequestRequest) ProtoMessage() {}
+
+func (x *RejectJoinRequestRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_room_room_proto_msgTypes[61]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RejectJoinRequestRequest.ProtoReflect.Descriptor instead.
+func (*RejectJoinRequestRequest) Descriptor() ([]byte, []int) {
+	return file_proto_room_room_proto_rawDescGZIP(), []int{61}
+}
+
+func (x *RejectJoinRequestRequest) GetRequestId() int64 {
+	if x != nil {
+		return x.RequestId
+	}
+	return 0
+}
+
+// Response to a reject join request request
+type RejectJoinRequestResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Success       bool                   `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
+	Message       string                 `protobuf:"bytes,2,opt,name=message,proto3" json:"message,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RejectJoinRequestResponse) Reset() {
+	*x = RejectJoinRequestResponse{}
+	mi := &file_proto_room_room_proto_msgTypes[62]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RejectJoinRequestResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RejectJoinRequestResponse) ProtoMessage() {}
+
+func (x *RejectJoinRequestResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_room_room_proto_msgTypes[62]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RejectJoinRequestResponse.ProtoReflect.Descriptor instead.
+func (*RejectJoinRequestResponse) Descriptor() ([]byte, []int) {
+	return file_proto_room_room_proto_rawDescGZIP(), []int{62}
+}
+
+func (x *RejectJoinRequestResponse) GetSuccess() bool {
+	if x != nil {
+		return x.Success
+	}
+	return false
+}
+
+func (x *RejectJoinRequestResponse) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+// One-to-one conversation between the user and a peer. Direct conversations
+// are rooms that only their two members can see or join, so chat RPCs take
+// room_id as for any other room.
+type DirectConversation struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	RoomId        int64                  `protobuf:"varint,1,opt,name=room_id,json=roomId,proto3" json:"room_id,omitempty"`
+	PeerUserId    int64                  `protobuf:"varint,2,opt,name=peer_user_id,json=peerUserId,proto3" json:"peer_user_id,omitempty"`
+	PeerUsername  string                 `protobuf:"bytes,3,opt,name=peer_username,json=peerUsername,proto3" json:"peer_username,omitempty"`
+	CreatedAt     string                 `protobuf:"bytes,4,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DirectConversation) Reset() {
+	*x = DirectConversation{}
+	mi := &file_proto_room_room_proto_msgTypes[63]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DirectConversation) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DirectConversation) ProtoMessage() {}
+
+func (x *DirectConversation) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_room_room_proto_msgTypes[63]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DirectConversation.ProtoReflect.Descriptor instead.
+func (*DirectConversation) Descriptor() ([]byte, []int) {
+	return file_proto_room_room_proto_rawDescGZIP(), []int{63}
+}
+
+func (x *DirectConversation) GetRoomId() int64 {
+	if x != nil {
+		return x.RoomId
+	}
+	return 0
+}
+
+func (x *DirectConversation) GetPeerUserId() int64 {
+	if x != nil {
+		return x.PeerUserId
+	}
+	return 0
+}
+
+func (x *DirectConversation) GetPeerUsername() string {
+	if x != nil {
+		return x.PeerUsername
+	}
+	return ""
+}
+
+func (x *DirectConversation) GetCreatedAt() string {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return ""
+}
+
+// Request to open a direct conversation
+type OpenDirectConversationRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	PeerUserId    int64                  `protobuf:"varint,1,opt,name=peer_user_id,json=peerUserId,proto3" json:"peer_user_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *OpenDirectConversationRequest) Reset() {
+	*x = OpenDirectConversationRequest{}
+	mi := &file_proto_room_room_proto_msgTypes[64]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *OpenDirectConversationRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*OpenDirectConversationRequest) ProtoMessage() {}
+
+func (x *OpenDirectConversationRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_room_room_proto_msgTypes[64]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use OpenDirectConversationRequest.ProtoReflect.Descriptor instead.
+func (*OpenDirectConversationRequest) Descriptor() ([]byte, []int) {
+	return file_proto_room_room_proto_rawDescGZIP(), []int{64}
+}
+
+func (x *OpenDirectConversationRequest) GetPeerUserId() int64 {
+	if x != nil {
+		return x.PeerUserId
+	}
+	return 0
+}
+
+// Response to an open direct conversation request
+type OpenDirectConversationResponse struct {
+	state        protoimpl.MessageState `protogen:"open.v1"`
+	Conversation *DirectConversation    `protobuf:"bytes,1,opt,name=conversation,proto3" json:"conversation,omitempty"`
+	// Whether the conversation was created by this request
+	Created       bool `protobuf:"varint,2,opt,name=created,proto3" json:"created,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *OpenDirectConversationResponse) Reset() {
+	*x = OpenDirectConversationResponse{}
+	mi := &file_proto_room_room_proto_msgTypes[65]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *OpenDirectConversationResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*OpenDirectConversationResponse) ProtoMessage() {}
+
+func (x *OpenDirectConversationResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_room_room_proto_msgTypes[65]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use OpenDirectConversationResponse.ProtoReflect.Descriptor instead.
+func (*OpenDirectConversationResponse) Descriptor() ([]byte, []int) {
+	return file_proto_room_room_proto_rawDescGZIP(), []int{65}
+}
+
+func (x *OpenDirectConversationResponse) GetConversation() *DirectConversation {
+	if x != nil {
+		return x.Conversation
+	}
+	return nil
+}
+
+func (x *OpenDirectConversationResponse) GetCreated() bool {
+	if x != nil {
+		return x.Created
+	}
+	return false
+}
+
+// Participant in a group conversation
+type GroupParticipant struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	UserId        int64                  `protobuf:"varint,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	Username      string                 `protobuf:"bytes,2,opt,name=username,proto3" json:"username,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GroupParticipant) Reset() {
+	*x = GroupParticipant{}
+	mi := &file_proto_room_room_proto_msgTypes[66]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GroupParticipant) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GroupParticipant) ProtoMessage() {}
+
+func (x *GroupParticipant) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_room_room_proto_msgTypes[66]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GroupParticipant.ProtoReflect.Descriptor instead.
+func (*GroupParticipant) Descriptor() ([]byte, []int) {
+	return file_proto_room_room_proto_rawDescGZIP(), []int{66}
+}
+
+func (x *GroupParticipant) GetUserId() int64 {
+	if x != nil {
+		return x.UserId
+	}
+	return 0
+}
+
+func (x *GroupParticipant) GetUsername() string {
+	if x != nil {
+		return x.Username
+	}
+	return ""
+}
+
+// Unnamed conversation between three or more users. Like direct
+// conversations, group conversations are rooms and chat RPCs take room_id.
+type GroupConversation struct {
+	state  protoimpl.MessageState `protogen:"open.v1"`
+	RoomId int64                  `protobuf:"varint,1,opt,name=room_id,json=roomId,proto3" json:"room_id,omitempty"`
+	// Derived from the other participants' usernames
+	DisplayName string `protobuf:"bytes,2,opt,name=display_name,json=displayName,proto3" json:"display_name,omitempty"`
+	// In the order they joined
+	Participants  []*GroupParticipant `protobuf:"bytes,3,rep,name=participants,proto3" json:"participants,omitempty"`
+	CreatedAt     string              `protobuf:"bytes,4,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GroupConversation) Reset() {
+	*x = GroupConversation{}
+	mi := &file_proto_room_room_proto_msgTypes[67]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GroupConversation) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GroupConversation) ProtoMessage() {}
+
+func (x *GroupConversation) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_room_room_proto_msgTypes[67]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GroupConversation.ProtoReflect.Descriptor instead.
+func (*GroupConversation) Descriptor() ([]byte, []int) {
+	return file_proto_room_room_proto_rawDescGZIP(), []int{67}
+}
+
+func (x *GroupConversation) GetRoomId() int64 {
+	if x != nil {
+		return x.RoomId
+	}
+	return 0
+}
+
+func (x *GroupConversation) GetDisplayName() string {
+	if x != nil {
+		return x.DisplayName
+	}
+	return ""
+}
+
+func (x *GroupConversation) GetParticipants() []*GroupParticipant {
+	if x != nil {
+		return x.Participants
+	}
+	return nil
+}
+
+func (x *GroupConversation) GetCreatedAt() string {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return ""
+}
+
+// Request to create a group conversation
+type CreateGroupConversationRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// The other participants; the user is added automatically
+	UserIds       []int64 `protobuf:"varint,1,rep,packed,name=user_ids,json=userIds,proto3" json:"user_ids,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreateGroupConversationRequest) Reset() {
+	*x = CreateGroupConversationRequest{}
+	mi := &file_proto_room_room_proto_msgTypes[68]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateGroupConversationRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateGroupConversationRequest) ProtoMessage() {}
+
+func (x *CreateGroupConversationRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_room_room_proto_msgTypes[68]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateGroupConversationRequest.ProtoReflect.Descriptor instead.
+func (*CreateGroupConversationRequest) Descriptor() ([]byte, []int) {
+	return file_proto_room_room_proto_rawDescGZIP(), []int{68}
+}
+
+func (x *CreateGroupConversationRequest) GetUserIds() []int64 {
+	if x != nil {
+		return x.UserIds
+	}
+	return nil
+}
+
+// Response to a create group conversation request
+type CreateGroupConversationResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Conversation  *GroupConversation     `protobuf:"bytes,1,opt,name=conversation,proto3" json:"conversation,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreateGroupConversationResponse) Reset() {
+	*x = CreateGroupConversationResponse{}
+	mi := &file_proto_room_room_proto_msgTypes[69]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateGroupConversationResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateGroupConversationResponse) ProtoMessage() {}
+
+func (x *CreateGroupConversationResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_room_room_proto_msgTypes[69]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateGroupConversationResponse.ProtoReflect.Descriptor instead.
+func (*CreateGroupConversationResponse) Descriptor() ([]byte, []int) {
+	return file_proto_room_room_proto_rawDescGZIP(), []int{69}
+}
+
+func (x *CreateGroupConversationResponse) GetConversation() *GroupConversation {
+	if x != nil {
+		return x.Conversation
+	}
+	return nil
+}
+
+// Request to add members to a group conversation
+type AddGroupConversationMembersRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	RoomId        int64                  `protobuf:"varint,1,opt,name=room_id,json=roomId,proto3" json:"room_id,omitempty"`
+	UserIds       []int64                `protobuf:"varint,2,rep,packed,name=user_ids,json=userIds,proto3" json:"user_ids,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *AddGroupConversationMembersRequest) Reset() {
+	*x = AddGroupConversationMembersRequest{}
+	mi := &file_proto_room_room_proto_msgTypes[70]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AddGroupConversationMembersRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AddGroupConversationMembersRequest) ProtoMessage() {}
+
+func (x *AddGroupConversationMembersRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_room_room_proto_msgTypes[70]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AddGroupConversationMembersRequest.ProtoReflect.Descriptor instead.
+func (*AddGroupConversationMembersRequest) Descriptor() ([]byte, []int) {
+	return file_proto_room_room_proto_rawDescGZIP(), []int{70}
+}
+
+func (x *AddGroupConversationMembersRequest) GetRoomId() int64 {
+	if x != nil {
+		return x.RoomId
+	}
+	return 0
+}
+
+func (x *AddGroupConversationMembersRequest) GetUserIds() []int64 {
+	if x != nil {
+		return x.UserIds
+	}
+	return nil
+}
+
+// Response to an add group conversation members request
+type AddGroupConversationMembersResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Success       bool                   `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
+	Message       string                 `protobuf:"bytes,2,opt,name=message,proto3" json:"message,omitempty"`
+	Conversation  *GroupConversation     `protobuf:"bytes,3,opt,name=conversation,proto3" json:"conversation,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *AddGroupConversationMembersResponse) Reset() {
+	*x = AddGroupConversationMembersResponse{}
+	mi := &file_proto_room_room_proto_msgTypes[71]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AddGroupConversationMembersResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AddGroupConversationMembersResponse) ProtoMessage() {}
+
+func (x *AddGroupConversationMembersResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_room_room_proto_msgTypes[71]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AddGroupConversationMembersResponse.ProtoReflect.Descriptor instead.
+func (*AddGroupConversationMembersResponse) Descriptor() ([]byte, []int) {
+	return file_proto_room_room_proto_rawDescGZIP(), []int{71}
+}
+
+func (x *AddGroupConversationMembersResponse) GetSuccess() bool {
+	if x != nil {
+		return x.Success
+	}
+	return false
+}
+
+func (x *AddGroupConversationMembersResponse) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+func (x *AddGroupConversationMembersResponse) GetConversation() *GroupConversation {
+	if x != nil {
+		return x.Conversation
+	}
+	return nil
+}
+
+var File_proto_room_room_proto protoreflect.FileDescriptor
+
+const file_proto_room_room_proto_rawDesc = "" +
+	"\n" +
+	"\x15proto/room/room.proto\x12\x04room\x1a\x1cgoogle/api/annotations.proto\"\xe1\x01\n" +
+	"\x11CreateRoomRequest\x12\x12\n" +
+	"\x04name\x18\x01 \x01(\tR\x04name\x12 \n" +
+	"\vdescription\x18\x02 \x01(\tR\vdescription\x12&\n" +
+	"\n" +
+	"creator_id\x18\x03 \x01(\x03B\x02\x18\x01H\x00R\tcreatorId\x88\x01\x01\x12\x1d\n" +
+	"\n" +
+	"is_private\x18\x04 \x01(\bR\tisPrivate\x12\x1f\n" +
+	"\vmax_members\x18\x05 \x01(\x03R\n" +
+	"maxMembers\x12\x1f\n" +
+	"\vjoin_policy\x18\x06 \x01(\tR\n" +
+	"joinPolicyB\r\n" +
+	"\v_creator_id\"\x86\x03\n" +
+	"\fRoomResponse\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\x03R\x02id\x12\x12\n" +
+	"\x04name\x18\x02 \x01(\tR\x04name\x12 \n" +
+	"\vdescription\x18\x03 \x01(\tR\vdescription\x12\x1d\n" +
+	"\n" +
+	"creator_id\x18\x04 \x01(\x03R\tcreatorId\x12\x1d\n" +
+	"\n" +
+	"is_private\x18\x05 \x01(\bR\tisPrivate\x12\x14\n" +
+	"\x05topic\x18\x06 \x01(\tR\x05topic\x12\x1d\n" +
+	"\n" +
+	"avatar_url\x18\a \x01(\tR\tavatarUrl\x12\x1a\n" +
+	"\barchived\x18\b \x01(\bR\barchived\x12!\n" +
+	"\fmember_count\x18\t \x01(\x03R\vmemberCount\x12(\n" +
+	"\x10last_activity_at\x18\n" +
+	" \x01(\tR\x0elastActivityAt\x12\x1f\n" +
+	"\vmax_members\x18\v \x01(\x03R\n" +
+	"maxMembers\x12\x1f\n" +
+	"\vjoin_policy\x18\f \x01(\tR\n" +
+	"joinPolicy\x12\x12\n" +
+	"\x04kind\x18\r \x01(\tR\x04kind\"?\n" +
+	"\x0fGetRoomsRequest\x12 \n" +
+	"\auser_id\x18\x01 \x01(\x03B\x02\x18\x01H\x00R\x06userId\x88\x01\x01B\n" +
+	"\n" +
+	"\b_user_id\"\xd3\x01\n" +
+	"\x10GetRoomsResponse\x12(\n" +
+	"\x05rooms\x18\x01 \x03(\v2\x12.room.RoomResponseR\x05rooms\x12K\n" +
+	"\x14direct_conversations\x18\x02 \x03(\v2\x18.room.DirectConversationR\x13directConversations\x12H\n" +
+	"\x13group_conversations\x18\x03 \x03(\v2\x17.room.GroupConversationR\x12groupConversations\"r\n" +
+	"\x0fJoinRoomRequest\x12\x17\n" +
+	"\aroom_id\x18\x01 \x01(\x03R\x06roomId\x12 \n" +
+	"\auser_id\x18\x02 \x01(\x03B\x02\x18\x01H\x00R\x06userId\x88\x01\x01\x12\x18\n" +
+	"\amessage\x18\x03 \x01(\tR\amessageB\n" +
+	"\n" +
+	"\b_user_id\"\x88\x01\n" +
+	"\x10JoinRoomResponse\x12\x18\n" +
+	"\asuccess\x18\x01 \x01(\bR\asuccess\x12\x18\n" +
+	"\amessage\x18\x02 \x01(\tR\amessage\x12\x18\n" +
+	"\apending\x18\x03 \x01(\bR\apending\x12&\n" +
+	"\x0fjoin_request_id\x18\x04 \x01(\x03R\rjoinRequestId\"Y\n" +
+	"\x10LeaveRoomRequest\x12\x17\n" +
+	"\aroom_id\x18\x01 \x01(\x03R\x06roomId\x12 \n" +
+	"\auser_id\x18\x02 \x01(\x03B\x02\x18\x01H\x00R\x06userId\x88\x01\x01B\n" +
+	"\n" +
+	"\b_user_id\"G\n" +
+	"\x11LeaveRoomResponse\x12\x18\n" +
+	"\asuccess\x18\x01 \x01(\bR\asuccess\x12\x18\n" +
+	"\amessage\x18\x02 \x01(\tR\amessage\"\\\n" +
+	"\x14SetMemberRoleRequest\x12\x17\n" +
+	"\aroom_id\x18\x01 \x01(\x03R\x06roomId\x12\x17\n" +
+	"\auser_id\x18\x02 \x01(\x03R\x06userId\x12\x12\n" +
+	"\x04role\x18\x03 \x01(\tR\x04role\"K\n" +
+	"\x15SetMemberRoleResponse\x12\x18\n" +
+	"\asuccess\x18\x01 \x01(\bR\asuccess\x12\x18\n" +
+	"\amessage\x18\x02 \x01(\tR\amessage\"U\n" +
+	"\x18TransferOwnershipRequest\x12\x17\n" +
+	"\aroom_id\x18\x01 \x01(\x03R\x06roomId\x12 \n" +
+	"\fnew_owner_id\x18\x02 \x01(\x03R\n" +
+	"newOwnerId\"O\n" +
+	"\x19TransferOwnershipResponse\x12\x18\n" +
+	"\asuccess\x18\x01 \x01(\bR\asuccess\x12\x18\n" +
+	"\amessage\x18\x02 \x01(\tR\amessage\"E\n" +
+	"\x11InviteUserRequest\x12\x17\n" +
+	"\aroom_id\x18\x01 \x01(\x03R\x06roomId\x12\x17\n" +
+	"\auser_id\x18\x02 \x01(\x03R\x06userId\"e\n" +
+	"\x12InviteUserResponse\x12\x18\n" +
+	"\asuccess\x18\x01 \x01(\bR\asuccess\x12\x18\n" +
+	"\amessage\x18\x02 \x01(\tR\amessage\x12\x1b\n" +
+	"\tinvite_id\x18\x03 \x01(\x03R\binviteId\"\xce\x01\n" +
+	"\x06Invite\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\x03R\x02id\x12\x17\n" +
+	"\aroom_id\x18\x02 \x01(\x03R\x06roomId\x12\x1b\n" +
+	"\troom_name\x18\x03 \x01(\tR\broomName\x12\x1d\n" +
+	"\n" +
+	"inviter_id\x18\x04 \x01(\x03R\tinviterId\x12!\n" +
+	"\finviter_name\x18\x05 \x01(\tR\vinviterName\x12\x1d\n" +
+	"\n" +
+	"created_at\x18\x06 \x01(\tR\tcreatedAt\x12\x1d\n" +
+	"\n" +
+	"expires_at\x18\a \x01(\tR\texpiresAt\"\x13\n" +
+	"\x11GetInvitesRequest\"<\n" +
+	"\x12GetInvitesResponse\x12&\n" +
+	"\ainvites\x18\x01 \x03(\v2\f.room.InviteR\ainvites\"2\n" +
+	"\x13AcceptInviteRequest\x12\x1b\n" +
+	"\tinvite_id\x18\x01 \x01(\x03R\binviteId\"c\n" +
+	"\x14AcceptInviteResponse\x12\x18\n" +
+	"\asuccess\x18\x01 \x01(\bR\asuccess\x12\x18\n" +
+	"\amessage\x18\x02 \x01(\tR\amessage\x12\x17\n" +
+	"\aroom_id\x18\x03 \x01(\x03R\x06roomId\"3\n" +
+	"\x14DeclineInviteRequest\x12\x1b\n" +
+	"\tinvite_id\x18\x01 \x01(\x03R\binviteId\"K\n" +
+	"\x15DeclineInviteResponse\x12\x18\n" +
+	"\asuccess\x18\x01 \x01(\bR\asuccess\x12\x18\n" +
+	"\amessage\x18\x02 \x01(\tR\amessage\"\x80\x01\n" +
+	"\x17CreateInviteCodeRequest\x12\x17\n" +
+	"\aroom_id\x18\x01 \x01(\x03R\x06roomId\x12\x19\n" +
+	"\bmax_uses\x18\x02 \x01(\x03R\amaxUses\x12\x1d\n" +
+	"\n" +
+	"expires_in\x18\x03 \x01(\x03R\texpiresIn\x12\x12\n" +
+	"\x04role\x18\x04 \x01(\tR\x04role\"]\n" +
+	"\rInviteCodeUse\x12\x17\n" +
+	"\auser_id\x18\x01 \x01(\x03R\x06userId\x12\x1a\n" +
+	"\busername\x18\x02 \x01(\tR\busername\x12\x17\n" +
+	"\aused_at\x18\x03 \x01(\tR\x06usedAt\"\xb1\x02\n" +
+	"\n" +
+	"InviteCode\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\x03R\x02id\x12\x12\n" +
+	"\x04code\x18\x02 \x01(\tR\x04code\x12\x17\n" +
+	"\aroom_id\x18\x03 \x01(\x03R\x06roomId\x12\x1d\n" +
+	"\n" +
+	"creator_id\x18\x04 \x01(\x03R\tcreatorId\x12\x12\n" +
+	"\x04role\x18\x05 \x01(\tR\x04role\x12\x19\n" +
+	"\bmax_uses\x18\x06 \x01(\x03R\amaxUses\x12\x12\n" +
+	"\x04uses\x18\a \x01(\x03R\x04uses\x12\x1d\n" +
+	"\n" +
+	"expires_at\x18\b \x01(\tR\texpiresAt\x12\x18\n" +
+	"\arevoked\x18\t \x01(\bR\arevoked\x12\x1d\n" +
+	"\n" +
+	"created_at\x18\n" +
+	" \x01(\tR\tcreatedAt\x12,\n" +
+	"\aused_by\x18\v \x03(\v2\x13.room.InviteCodeUseR\x06usedBy\"1\n" +
+	"\x16ListInviteCodesRequest\x12\x17\n" +
+	"\aroom_id\x18\x01 \x01(\x03R\x06roomId\"A\n" +
+	"\x17ListInviteCodesResponse\x12&\n" +
+	"\x05codes\x18\x01 \x03(\v2\x10.room.InviteCodeR\x05codes\"F\n" +
+	"\x17RevokeInviteCodeRequest\x12\x17\n" +
+	"\aroom_id\x18\x01 \x01(\x03R\x06roomId\x12\x12\n" +
+	"\x04code\x18\x02 \x01(\tR\x04code\"N\n" +
+	"\x18RevokeInviteCodeResponse\x12\x18\n" +
+	"\asuccess\x18\x01 \x01(\bR\asuccess\x12\x18\n" +
+	"\amessage\x18\x02 \x01(\tR\amessage\"-\n" +
+	"\x17JoinRoomByInviteRequest\x12\x12\n" +
+	"\x04code\x18\x01 \x01(\tR\x04code\"{\n" +
+	"\x18JoinRoomByInviteResponse\x12\x18\n" +
+	"\asuccess\x18\x01 \x01(\bR\asuccess\x12\x18\n" +
+	"\amessage\x18\x02 \x01(\tR\amessage\x12\x17\n" +
+	"\aroom_id\x18\x03 \x01(\x03R\x06roomId\x12\x12\n" +
+	"\x04role\x18\x04 \x01(\tR\x04role\"E\n" +
+	"\x11KickMemberRequest\x12\x17\n" +
+	"\aroom_id\x18\x01 \x01(\x03R\x06roomId\x12\x17\n" +
+	"\auser_id\x18\x02 \x01(\x03R\x06userId\"H\n" +
+	"\x12KickMemberResponse\x12\x18\n" +
+	"\asuccess\x18\x01 \x01(\bR\asuccess\x12\x18\n" +
+	"\amessage\x18\x02 \x01(\tR\amessage\"x\n" +
+	"\x10BanMemberRequest\x12\x17\n" +
+	"\aroom_id\x18\x01 \x01(\x03R\x06roomId\x12\x17\n" +
+	"\auser_id\x18\x02 \x01(\x03R\x06userId\x12\x1a\n" +
+	"\bduration\x18\x03 \x01(\x03R\bduration\x12\x16\n" +
+	"\x06reason\x18\x04 \x01(\tR\x06reason\"G\n" +
+	"\x11BanMemberResponse\x12\x18\n" +
+	"\asuccess\x18\x01 \x01(\bR\asuccess\x12\x18\n" +
+	"\amessage\x18\x02 \x01(\tR\amessage\"F\n" +
+	"\x12UnbanMemberRequest\x12\x17\n" +
+	"\aroom_id\x18\x01 \x01(\x03R\x06roomId\x12\x17\n" +
+	"\auser_id\x18\x02 \x01(\x03R\x06userId\"I\n" +
+	"\x13UnbanMemberResponse\x12\x18\n" +
+	"\asuccess\x18\x01 \x01(\bR\asuccess\x12\x18\n" +
+	"\amessage\x18\x02 \x01(\tR\amessage\"y\n" +
+	"\x11MuteMemberRequest\x12\x17\n" +
+	"\aroom_id\x18\x01 \x01(\x03R\x06roomId\x12\x17\n" +
+	"\auser_id\x18\x02 \x01(\x03R\x06userId\x12\x1a\n" +
+	"\bduration\x18\x03 \x01(\x03R\bduration\x12\x16\n" +
+	"\x06reason\x18\x04 \x01(\tR\x06reason\"H\n" +
+	"\x12MuteMemberResponse\x12\x18\n" +
+	"\asuccess\x18\x01 \x01(\bR\asuccess\x12\x18\n" +
+	"\amessage\x18\x02 \x01(\tR\amessage\"G\n" +
+	"\x13UnmuteMemberRequest\x12\x17\n" +
+	"\aroom_id\x18\x01 \x01(\x03R\x06roomId\x12\x17\n" +
+	"\auser_id\x18\x02 \x01(\x03R\x06userId\"J\n" +
+	"\x14UnmuteMemberResponse\x12\x18\n" +
+	"\asuccess\x18\x01 \x01(\bR\asuccess\x12\x18\n" +
+	"\amessage\x18\x02 \x01(\tR\amessage\"\xc9\x02\n" +
+	"\x11UpdateRoomRequest\x12\x17\n" +
+	"\aroom_id\x18\x01 \x01(\x03R\x06roomId\x12\x17\n" +
+	"\x04name\x18\x02 \x01(\tH\x00R\x04name\x88\x01\x01\x12%\n" +
+	"\vdescription\x18\x03 \x01(\tH\x01R\vdescription\x88\x01\x01\x12\x19\n" +
+	"\x05topic\x18\x04 \x01(\tH\x02R\x05topic\x88\x01\x01\x12\"\n" +
+	"\n" +
+	"avatar_url\x18\x05 \x01(\tH\x03R\tavatarUrl\x88\x01\x01\x12$\n" +
+	"\vmax_members\x18\x06 \x01(\x03H\x04R\n" +
+	"maxMembers\x88\x01\x01\x12$\n" +
+	"\vjoin_policy\x18\a \x01(\tH\x05R\n" +
+	"joinPolicy\x88\x01\x01B\a\n" +
+	"\x05_nameB\x0e\n" +
+	"\f_descriptionB\b\n" +
+	"\x06_topicB\r\n" +
+	"\v_avatar_urlB\x0e\n" +
+	"\f_max_membersB\x0e\n" +
+	"\f_join_policy\"-\n" +
+	"\x12ArchiveRoomRequest\x12\x17\n" +
+	"\aroom_id\x18\x01 \x01(\x03R\x06roomId\"I\n" +
+	"\x13ArchiveRoomResponse\x12\x18\n" +
+	"\asuccess\x18\x01 \x01(\bR\asuccess\x12\x18\n" +
+	"\amessage\x18\x02 \x01(\tR\amessage\"/\n" +
+	"\x14UnarchiveRoomRequest\x12\x17\n" +
+	"\aroom_id\x18\x01 \x01(\x03R\x06roomId\"K\n" +
+	"\x15UnarchiveRoomResponse\x12\x18\n" +
+	"\asuccess\x18\x01 \x01(\bR\asuccess\x12\x18\n" +
+	"\amessage\x18\x02 \x01(\tR\amessage\",\n" +
+	"\x11DeleteRoomRequest\x12\x17\n" +
+	"\aroom_id\x18\x01 \x01(\x03R\x06roomId\"H\n" +
+	"\x12DeleteRoomResponse\x12\x18\n" +
+	"\asuccess\x18\x01 \x01(\bR\asuccess\x12\x18\n" +
+	"\amessage\x18\x02 \x01(\tR\amessage\"x\n" +
+	"\x12SearchRoomsRequest\x12\x14\n" +
+	"\x05query\x18\x01 \x01(\tR\x05query\x12\x17\n" +
+	"\asort_by\x18\x02 \x01(\tR\x06sortBy\x12\x1b\n" +
+	"\tpage_size\x18\x03 \x01(\x03R\bpageSize\x12\x16\n" +
+	"\x06cursor\x18\x04 \x01(\tR\x06cursor\"`\n" +
+	"\x13SearchRoomsResponse\x12(\n" +
+	"\x05rooms\x18\x01 \x03(\v2\x12.room.RoomResponseR\x05rooms\x12\x1f\n" +
+	"\vnext_cursor\x18\x02 \x01(\tR\n" +
+	"nextCursor\"\x8a\x01\n" +
+	"\n" +
+	"RoomMember\x12\x17\n" +
+	"\auser_id\x18\x01 \x01(\x03R\x06userId\x12\x1a\n" +
+	"\busername\x18\x02 \x01(\tR\busername\x12\x12\n" +
+	"\x04role\x18\x03 \x01(\tR\x04role\x12\x1b\n" +
+	"\tjoined_at\x18\x04 \x01(\tR\bjoinedAt\x12\x16\n" +
+	"\x06online\x18\x05 \x01(\bR\x06online\")\n" +
+	"\x0eGetRoomRequest\x12\x17\n" +
+	"\aroom_id\x18\x01 \x01(\x03R\x06roomId\"k\n" +
+	"\x0fGetRoomResponse\x12&\n" +
+	"\x04room\x18\x01 \x01(\v2\x12.room.RoomResponseR\x04room\x120\n" +
+	"\n" +
+	"membership\x18\x02 \x01(\v2\x10.room.RoomMemberR\n" +
+	"membership\"_\n" +
+	"\x16ListRoomMembersRequest\x12\x17\n" +
+	"\aroom_id\x18\x01 \x01(\x03R\x06roomId\x12\x14\n" +
+	"\x05limit\x18\x02 \x01(\x03R\x05limit\x12\x16\n" +
+	"\x06offset\x18\x03 \x01(\x03R\x06offset\"[\n" +
+	"\x17ListRoomMembersResponse\x12*\n" +
+	"\amembers\x18\x01 \x03(\v2\x10.room.RoomMemberR\amembers\x12\x14\n" +
+	"\x05total\x18\x02 \x01(\x03R\x05total\"\xf8\x01\n" +
+	"\vJoinRequest\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\x03R\x02id\x12\x17\n" +
+	"\aroom_id\x18\x02 \x01(\x03R\x06roomId\x12\x1b\n" +
+	"\troom_name\x18\x03 \x01(\tR\broomName\x12\x17\n" +
+	"\auser_id\x18\x04 \x01(\x03R\x06userId\x12\x1a\n" +
+	"\busername\x18\x05 \x01(\tR\busername\x12\x18\n" +
+	"\amessage\x18\x06 \x01(\tR\amessage\x12\x16\n" +
+	"\x06status\x18\a \x01(\tR\x06status\x12\x1d\n" +
+	"\n" +
+	"created_at\x18\b \x01(\tR\tcreatedAt\x12\x1d\n" +
+	"\n" +
+	"decided_at\x18\t \x01(\tR\tdecidedAt\"2\n" +
+	"\x17ListJoinRequestsRequest\x12\x17\n" +
+	"\aroom_id\x18\x01 \x01(\x03R\x06roomId\"I\n" +
+	"\x18ListJoinRequestsResponse\x12-\n" +
+	"\brequests\x18\x01 \x03(\v2\x11.room.JoinRequestR\brequests\"\x18\n" +
+	"\x16GetJoinRequestsRequest\"H\n" +
+	"\x17GetJoinRequestsResponse\x12-\n" +
+	"\brequests\x18\x01 \x03(\v2\x11.room.JoinRequestR\brequests\":\n" +
+	"\x19ApproveJoinRequestRequest\x12\x1d\n" +
+	"\n" +
+	"request_id\x18\x01 \x01(\x03R\trequestId\"P\n" +
+	"\x1aApproveJoinRequestResponse\x12\x18\n" +
+	"\asuccess\x18\x01 \x01(\bR\asuccess\x12\x18\n" +
+	"\amessage\x18\x02 \x01(\tR\amessage\"9\n" +
+	"\x18RejectJoinRequestRequest\x12\x1d\n" +
+	"\n" +
+	"request_id\x18\x01 \x01(\x03R\trequestId\"O\n" +
+	"\x19RejectJoinRequestResponse\x12\x18\n" +
+	"\asuccess\x18\x01 \x01(\bR\asuccess\x12\x18\n" +
+	"\amessage\x18\x02 \x01(\tR\amessage\"\x93\x01\n" +
+	"\x12DirectConversation\x12\x17\n" +
+	"\aroom_id\x18\x01 \x01(\x03R\x06roomId\x12 \n" +
+	"\fpeer_user_id\x18\x02 \x01(\x03R\n" +
+	"peerUserId\x12#\n" +
+	"\rpeer_username\x18\x03 \x01(\tR\fpeerUsername\x12\x1d\n" +
+	"\n" +
+	"created_at\x18\x04 \x01(\tR\tcreatedAt\"A\n" +
+	"\x1dOpenDirectConversationRequest\x12 \n" +
+	"\fpeer_user_id\x18\x01 \x01(\x03R\n" +
+	"peerUserId\"x\n" +
+	"\x1eOpenDirectConversationResponse\x12<\n" +
+	"\fconversation\x18\x01 \x01(\v2\x18.room.DirectConversationR\fconversation\x12\x18\n" +
+	"\acreated\x18\x02 \x01(\bR\acreated\"G\n" +
+	"\x10GroupParticipant\x12\x17\n" +
+	"\auser_id\x18\x01 \x01(\x03R\x06userId\x12\x1a\n" +
+	"\busername\x18\x02 \x01(\tR\busername\"\xaa\x01\n" +
+	"\x11GroupConversation\x12\x17\n" +
+	"\aroom_id\x18\x01 \x01(\x03R\x06roomId\x12!\n" +
+	"\fdisplay_name\x18\x02 \x01(\tR\vdisplayName\x12:\n" +
+	"\fparticipants\x18\x03 \x03(\v2\x16.room.GroupParticipantR\fparticipants\x12\x1d\n" +
+	"\n" +
+	"created_at\x18\x04 \x01(\tR\tcreatedAt\";\n" +
+	"\x1eCreateGroupConversationRequest\x12\x19\n" +
+	"\buser_ids\x18\x01 \x03(\x03R\auserIds\"^\n" +
+	"\x1fCreateGroupConversationResponse\x12;\n" +
+	"\fconversation\x18\x01 \x01(\v2\x17.room.GroupConversationR\fconversation\"X\n" +
+	"\"AddGroupConversationMembersRequest\x12\x17\n" +
+	"\aroom_id\x18\x01 \x01(\x03R\x06roomId\x12\x19\n" +
+	"\buser_ids\x18\x02 \x03(\x03R\auserIds\"\x96\x01\n" +
+	"#AddGroupConversationMembersResponse\x12\x18\n" +
+	"\asuccess\x18\x01 \x01(\bR\asuccess\x12\x18\n" +
+	"\amessage\x18\x02 \x01(\tR\amessage\x12;\n" +
+	"\fconversation\x18\x03 \x01(\v2\x17.room.GroupConversationR\fconversation2\xfc\x1b\n" +
+	"\vRoomService\x12W\n" +
+	"\n" +
+	"CreateRoom\x12\x17.room.CreateRoomRequest\x1a\x12.room.RoomResponse\"\x1c\x82\xd3\xe4\x93\x02\x16:\x01*\"\x11/room/create-room\x12U\n" +
+	"\bGetRooms\x12\x15.room.GetRoomsRequest\x1a\x16.room.GetRoomsResponse\"\x1a\x82\xd3\xe4\x93\x02\x14:\x01*\"\x0f/room/get-rooms\x12U\n" +
+	"\bJoinRoom\x12\x15.room.JoinRoomRequest\x1a\x16.room.JoinRoomResponse\"\x1a\x82\xd3\xe4\x93\x02\x14:\x01*\"\x0f/room/join-room\x12Y\n" +
+	"\tLeaveRoom\x12\x16.room.LeaveRoomRequest\x1a\x17.room.LeaveRoomResponse\"\x1b\x82\xd3\xe4\x93\x02\x15:\x01*\"\x10/room/leave-room\x12j\n" +
+	"\rSetMemberRole\x12\x1a.room.SetMemberRoleRequest\x1a\x1b.room.SetMemberRoleResponse\" \x82\xd3\xe4\x93\x02\x1a:\x01*\"\x15/room/set-member-role\x12y\n" +
+	"\x11TransferOwnership\x12\x1e.room.TransferOwnershipRequest\x1a\x1f.room.TransferOwnershipResponse\"#\x82\xd3\xe4\x93\x02\x1d:\x01*\"\x18/room/transfer-ownership\x12]\n" +
+	"\n" +
+	"InviteUser\x12\x17.room.InviteUserRequest\x1a\x18.room.InviteUserResponse\"\x1c\x82\xd3\xe4\x93\x02\x16:\x01*\"\x11/room/invite-user\x12]\n" +
+	"\n" +
+	"GetInvites\x12\x17.room.GetInvitesRequest\x1a\x18.room.GetInvitesResponse\"\x1c\x82\xd3\xe4\x93\x02\x16:\x01*\"\x11/room/get-invites\x12e\n" +
+	"\fAcceptInvite\x12\x19.room.AcceptInviteRequest\x1a\x1a.room.AcceptInviteResponse\"\x1e\x82\xd3\xe4\x93\x02\x18:\x01*\"\x13/room/accept-invite\x12i\n" +
+	"\rDeclineInvite\x12\x1a.room.DeclineInviteRequest\x1a\x1b.room.DeclineInviteResponse\"\x1f\x82\xd3\xe4\x93\x02\x19:\x01*\"\x14/room/decline-invite\x12h\n" +
+	"\x10CreateInviteCode\x12\x1d.room.CreateInviteCodeRequest\x1a\x10.room.InviteCode\"#\x82\xd3\xe4\x93\x02\x1d:\x01*\"\x18/room/create-invite-code\x12r\n" +
+	"\x0fListInviteCodes\x12\x1c.room.ListInviteCodesRequest\x1a\x1d.room.ListInviteCodesResponse\"\"\x82\xd3\xe4\x93\x02\x1c:\x01*\"\x17/room/list-invite-codes\x12v\n" +
+	"\x10RevokeInviteCode\x12\x1d.room.RevokeInviteCodeRequest\x1a\x1e.room.RevokeInviteCodeResponse\"#\x82\xd3\xe4\x93\x02\x1d:\x01*\"\x18/room/revoke-invite-code\x12w\n" +
+	"\x10JoinRoomByInvite\x12\x1d.room.JoinRoomByInviteRequest\x1a\x1e.room.JoinRoomByInviteResponse\"$\x82\xd3\xe4\x93\x02\x1e:\x01*\"\x19/room/join-room-by-invite\x12]\n" +
+	"\n" +
+	"KickMember\x12\x17.room.KickMemberRequest\x1a\x18.room.KickMemberResponse\"\x1c\x82\xd3\xe4\x93\x02\x16:\x01*\"\x11/room/kick-member\x12Y\n" +
+	"\tBanMember\x12\x16.room.BanMemberRequest\x1a\x17.room.BanMemberResponse\"\x1b\x82\xd3\xe4\x93\x02\x15:\x01*\"\x10/room/ban-member\x12a\n" +
+	"\vUnbanMember\x12\x18.room.UnbanMemberRequest\x1a\x19.room.UnbanMemberResponse\"\x1d\x82\xd3\xe4\x93\x02\x17:\x01*\"\x12/room/unban-member\x12]\n" +
+	"\n" +
+	"MuteMember\x12\x17.room.MuteMemberRequest\x1a\x18.room.MuteMemberResponse\"\x1c\x82\xd3\xe4\x93\x02\x16:\x01*\"\x11/room/mute-member\x12e\n" +
+	"\fUnmuteMember\x12\x19.room.UnmuteMemberRequest\x1a\x1a.room.UnmuteMemberResponse\"\x1e\x82\xd3\xe4\x93\x02\x18:\x01*\"\x13/room/unmute-member\x12W\n" +
+	"\n" +
+	"UpdateRoom\x12\x17.room.UpdateRoomRequest\x1a\x12.room.RoomResponse\"\x1c\x82\xd3\xe4\x93\x02\x16:\x01*\"\x11/room/update-room\x12a\n" +
+	"\vArchiveRoom\x12\x18.room.ArchiveRoomRequest\x1a\x19.room.ArchiveRoomResponse\"\x1d\x82\xd3\xe4\x93\x02\x17:\x01*\"\x12/room/archive-room\x12i\n" +
+	"\rUnarchiveRoom\x12\x1a.room.UnarchiveRoomRequest\x1a\x1b.room.UnarchiveRoomResponse\"\x1f\x82\xd3\xe4\x93\x02\x19:\x01*\"\x14/room/unarchive-room\x12]\n" +
+	"\n" +
+	"DeleteRoom\x12\x17.room.DeleteRoomRequest\x1a\x18.room.DeleteRoomResponse\"\x1c\x82\xd3\xe4\x93\x02\x16:\x01*\"\x11/room/delete-room\x12a\n" +
+	"\vSearchRooms\x12\x18.room.SearchRoomsRequest\x1a\x19.room.SearchRoomsResponse\"\x1d\x82\xd3\xe4\x93\x02\x17:\x01*\"\x12/room/search-rooms\x12Q\n" +
+	"\aGetRoom\x12\x14.room.GetRoomRequest\x1a\x15.room.GetRoomResponse\"\x19\x82\xd3\xe4\x93\x02\x13:\x01*\"\x0e/room/get-room\x12r\n" +
+	"\x0fListRoomMembers\x12\x1c.room.ListRoomMembersRequest\x1a\x1d.room.ListRoomMembersResponse\"\"\x82\xd3\xe4\x93\x02\x1c:\x01*\"\x17/room/list-room-members\x12v\n" +
+	"\x10ListJoinRequests\x12\x1d.room.ListJoinRequestsRequest\x1a\x1e.room.ListJoinRequestsResponse\"#\x82\xd3\xe4\x93\x02\x1d:\x01*\"\x18/room/list-join-requests\x12r\n" +
+	"\x0fGetJoinRequests\x12\x1c.room.GetJoinRequestsRequest\x1a\x1d.room.GetJoinRequestsResponse\"\"\x82\xd3\xe4\x93\x02\x1c:\x01*\"\x17/room/get-join-requests\x12~\n" +
+	"\x12ApproveJoinRequest\x12\x1f.room.ApproveJoinRequestRequest\x1a .room.ApproveJoinRequestResponse\"%\x82\xd3\xe4\x93\x02\x1f:\x01*\"\x1a/room/approve-join-request\x12z\n" +
+	"\x11RejectJoinRequest\x12\x1e.room.RejectJoinRequestRequest\x1a\x1f.room.RejectJoinRequestResponse\"$\x82\xd3\xe4\x93\x02\x1e:\x01*\"\x19/room/reject-join-request\x12\x8e\x01\n" +
+	"\x16OpenDirectConversation\x12#.room.OpenDirectConversationRequest\x1a$.room.OpenDirectConversationResponse\")\x82\xd3\xe4\x93\x02#:\x01*\"\x1e/room/open-direct-conversation\x12\x92\x01\n" +
+	"\x17CreateGroupConversation\x12$.room.CreateGroupConversationRequest\x1a%.room.CreateGroupConversationResponse\"*\x82\xd3\xe4\x93\x02$:\x01*\"\x1f/room/create-group-conversation\x12\xa3\x01\n" +
+	"\x1bAddGroupConversationMembers\x12(.room.AddGroupConversationMembersRequest\x1a).room.AddGroupConversationMembersResponse\"/\x82\xd3\xe4\x93\x02):\x01*\"$/room/add-group-conversation-membersB Z\x1egrpc-messenger-core/proto/roomb\x06proto3"
 
 var (
 	file_proto_room_room_proto_rawDescOnce sync.Once
@@ -505,32 +4620,169 @@ func file_proto_room_room_proto_rawDescGZIP() []byte {
 	return file_proto_room_room_proto_rawDescData
 }
 
-var file_proto_room_room_proto_msgTypes = make([]protoimpl.MessageInfo, 8)
+var file_proto_room_room_proto_msgTypes = make([]protoimpl.MessageInfo, 72)
 var file_proto_room_room_proto_goTypes = []any{
-	(*CreateRoomRequest)(nil), // 0: room.CreateRoomRequest
-	(*RoomResponse)(nil),      // 1: room.RoomResponse
-	(*GetRoomsRequest)(nil),   // 2: room.GetRoomsRequest
-	(*GetRoomsResponse)(nil),  // 3: room.GetRoomsResponse
-	(*JoinRoomRequest)(nil),   // 4: room.JoinRoomRequest
-	(*JoinRoomResponse)(nil),  // 5: room.JoinRoomResponse
-	(*LeaveRoomRequest)(nil),  // 6: room.LeaveRoomRequest
-	(*LeaveRoomResponse)(nil), // 7: room.LeaveRoomResponse
+	(*CreateRoomRequest)(nil),                   // 0: room.CreateRoomRequest
+	(*RoomResponse)(nil),                        // 1: room.RoomResponse
+	(*GetRoomsRequest)(nil),                     // 2: room.GetRoomsRequest
+	(*GetRoomsResponse)(nil),                    // 3: room.GetRoomsResponse
+	(*JoinRoomRequest)(nil),                     // 4: room.JoinRoomRequest
+	(*JoinRoomResponse)(nil),                    // 5: room.JoinRoomResponse
+	(*LeaveRoomRequest)(nil),                    // 6: room.LeaveRoomRequest
+	(*LeaveRoomResponse)(nil),                   // 7: room.LeaveRoomResponse
+	(*SetMemberRoleRequest)(nil),                // 8: room.SetMemberRoleRequest
+	(*SetMemberRoleResponse)(nil),               // 9: room.SetMemberRoleResponse
+	(*TransferOwnershipRequest)(nil),            // 10: room.TransferOwnershipRequest
+	(*TransferOwnershipResponse)(nil),           // 11: room.TransferOwnershipResponse
+	(*InviteUserRequest)(nil),                   // 12: room.InviteUserRequest
+	(*InviteUserResponse)(nil),                  // 13: room.InviteUserResponse
+	(*Invite)(nil),                              // 14: room.Invite
+	(*GetInvitesRequest)(nil),                   // 15: room.GetInvitesRequest
+	(*GetInvitesResponse)(nil),                  // 16: room.GetInvitesResponse
+	(*AcceptInviteRequest)(nil),                 // 17: room.AcceptInviteRequest
+	(*AcceptInviteResponse)(nil),                // 18: room.AcceptInviteResponse
+	(*DeclineInviteRequest)(nil),                // 19: room.DeclineInviteRequest
+	(*DeclineInviteResponse)(nil),               // 20: room.DeclineInviteResponse
+	(*CreateInviteCodeRequest)(nil),             // 21: room.CreateInviteCodeRequest
+	(*InviteCodeUse)(nil),                       // 22: room.InviteCodeUse
+	(*InviteCode)(nil),                          // 23: room.InviteCode
+	(*ListInviteCodesRequest)(nil),              // 24: room.ListInviteCodesRequest
+	(*ListInviteCodesResponse)(nil),             // 25: room.ListInviteCodesResponse
+	(*RevokeInviteCodeRequest)(nil),             // 26: room.RevokeInviteCodeRequest
+	(*RevokeInviteCodeResponse)(nil),            // 27: room.RevokeInviteCodeResponse
+	(*JoinRoomByInviteRequest)(nil),             // 28: room.JoinRoomByInviteRequest
+	(*JoinRoomByInviteResponse)(nil),            // 29: room.JoinRoomByInviteResponse
+	(*KickMemberRequest)(nil),                   // 30: room.KickMemberRequest
+	(*KickMemberResponse)(nil),                  // 31: room.KickMemberResponse
+	(*BanMemberRequest)(nil),                    // 32: room.BanMemberRequest
+	(*BanMemberResponse)(nil),                   // 33: room.BanMemberResponse
+	(*UnbanMemberRequest)(nil),                  // 34: room.UnbanMemberRequest
+	(*UnbanMemberResponse)(nil),                 // 35: room.UnbanMemberResponse
+	(*MuteMemberRequest)(nil),                   // 36: room.MuteMemberRequest
+	(*MuteMemberResponse)(nil),                  // 37: room.MuteMemberResponse
+	(*UnmuteMemberRequest)(nil),                 // 38: room.UnmuteMemberRequest
+	(*UnmuteMemberResponse)(nil),                // 39: room.UnmuteMemberResponse
+	(*UpdateRoomRequest)(nil),                   // 40: room.UpdateRoomRequest
+	(*ArchiveRoomRequest)(nil),                  // 41: room.ArchiveRoomRequest
+	(*ArchiveRoomResponse)(nil),                 // 42: room.ArchiveRoomResponse
+	(*UnarchiveRoomRequest)(nil),                // 43: room.UnarchiveRoomRequest
+	(*UnarchiveRoomResponse)(nil),               // 44: room.UnarchiveRoomResponse
+	(*DeleteRoomRequest)(nil),                   // 45: room.DeleteRoomRequest
+	(*DeleteRoomResponse)(nil),                  // 46: room.DeleteRoomResponse
+	(*SearchRoomsRequest)(nil),                  // 47: room.SearchRoomsRequest
+	(*SearchRoomsResponse)(nil),                 // 48: room.SearchRoomsResponse
+	(*RoomMember)(nil),                          // 49: room.RoomMember
+	(*GetRoomRequest)(nil),                      // 50: room.GetRoomRequest
+	(*GetRoomResponse)(nil),                     // 51: room.GetRoomResponse
+	(*ListRoomMembersRequest)(nil),              // 52: room.ListRoomMembersRequest
+	(*ListRoomMembersResponse)(nil),             // 53: room.ListRoomMembersResponse
+	(*JoinRequest)(nil),                         // 54: room.JoinRequest
+	(*ListJoinRequestsRequest)(nil),             // 55: room.ListJoinRequestsRequest
+	(*ListJoinRequestsResponse)(nil),            // 56: room.ListJoinRequestsResponse
+	(*GetJoinRequestsRequest)(nil),              // 57: room.GetJoinRequestsRequest
+	(*GetJoinRequestsResponse)(nil),             // 58: room.GetJoinRequestsResponse
+	(*ApproveJoinRequestRequest)(nil),           // 59: room.ApproveJoinRequestRequest
+	(*ApproveJoinRequestResponse)(nil),          // 60: room.ApproveJoinRequestResponse
+	(*RejectJoinRequestRequest)(nil),            // 61: room.RejectJoinRequestRequest
+	(*RejectJoinRequestResponse)(nil),           // 62: room.RejectJoinRequestResponse
+	(*DirectConversation)(nil),                  // 63: room.DirectConversation
+	(*OpenDirectConversationRequest)(nil),       // 64: room.OpenDirectConversationRequest
+	(*OpenDirectConversationResponse)(nil),      // 65: room.OpenDirectConversationResponse
+	(*GroupParticipant)(nil),                    // 66: room.GroupParticipant
+	(*GroupConversation)(nil),                   // 67: room.GroupConversation
+	(*CreateGroupConversationRequest)(nil),      // 68: room.CreateGroupConversationRequest
+	(*CreateGroupConversationResponse)(nil),     // 69: room.CreateGroupConversationResponse
+	(*AddGroupConversationMembersRequest)(nil),  // 70: room.AddGroupConversationMembersRequest
+	(*AddGroupConversationMembersResponse)(nil), // 71: room.AddGroupConversationMembersResponse
 }
 var file_proto_room_room_proto_depIdxs = []int32{
-	1, // 0: room.GetRoomsResponse.rooms:type_name -> room.RoomResponse
-	0, // 1: room.RoomService.CreateRoom:input_type -> room.CreateRoomRequest
-	2, // 2: room.RoomService.GetRooms:input_type -> room.GetRoomsRequest
-	4, // 3: room.RoomService.JoinRoom:input_type -> room.JoinRoomRequest
-	6, // 4: room.RoomService.LeaveRoom:input_type -> room.LeaveRoomRequest
-	1, // 5: room.RoomService.CreateRoom:output_type -> room.RoomResponse
-	3, // 6: room.RoomService.GetRooms:output_type -> room.GetRoomsResponse
-	5, // 7: room.RoomService.JoinRoom:output_type -> room.JoinRoomResponse
-	7, // 8: room.RoomService.LeaveRoom:output_type -> room.LeaveRoomResponse
-	5, // [5:9] is the sub-list for method output_type
-	1, // [1:5] is the sub-list for method input_type
-	1, // [1:1] is the sub-list for extension type_name
-	1, // [1:1] is the sub-list for extension extendee
-	0, // [0:1] is the sub-list for field type_name
+	1,  // 0: room.GetRoomsResponse.rooms:type_name -> room.RoomResponse
+	63, // 1: room.GetRoomsResponse.direct_conversations:type_name -> room.DirectConversation
+	67, // 2: room.GetRoomsResponse.group_conversations:type_name -> room.GroupConversation
+	14, // 3: room.GetInvitesResponse.invites:type_name -> room.Invite
+	22, // 4: room.InviteCode.used_by:type_name -> room.InviteCodeUse
+	23, // 5: room.ListInviteCodesResponse.codes:type_name -> room.InviteCode
+	1,  // 6: room.SearchRoomsResponse.rooms:type_name -> room.RoomResponse
+	1,  // 7: room.GetRoomResponse.room:type_name -> room.RoomResponse
+	49, // 8: room.GetRoomResponse.membership:type_name -> room.RoomMember
+	49, // 9: room.ListRoomMembersResponse.members:type_name -> room.RoomMember
+	54, // 10: room.ListJoinRequestsResponse.requests:type_name -> room.JoinRequest
+	54, // 11: room.GetJoinRequestsResponse.requests:type_name -> room.JoinRequest
+	63, // 12: room.OpenDirectConversationResponse.conversation:type_name -> room.DirectConversation
+	66, // 13: room.GroupConversation.participants:type_name -> room.GroupParticipant
+	67, // 14: room.CreateGroupConversationResponse.conversation:type_name -> room.GroupConversation
+	67, // 15: room.AddGroupConversationMembersResponse.conversation:type_name -> room.GroupConversation
+	0,  // 16: room.RoomService.CreateRoom:input_type -> room.CreateRoomRequest
+	2,  // 17: room.RoomService.GetRooms:input_type -> room.GetRoomsRequest
+	4,  // 18: room.RoomService.JoinRoom:input_type -> room.JoinRoomRequest
+	6,  // 19: room.RoomService.LeaveRoom:input_type -> room.LeaveRoomRequest
+	8,  // 20: room.RoomService.SetMemberRole:input_type -> room.SetMemberRoleRequest
+	10, // 21: room.RoomService.TransferOwnership:input_type -> room.TransferOwnershipRequest
+	12, // 22: room.RoomService.InviteUser:input_type -> room.InviteUserRequest
+	15, // 23: room.RoomService.GetInvites:input_type -> room.GetInvitesRequest
+	17, // 24: room.RoomService.AcceptInvite:input_type -> room.AcceptInviteRequest
+	19, // 25: room.RoomService.DeclineInvite:input_type -> room.DeclineInviteRequest
+	21, // 26: room.RoomService.CreateInviteCode:input_type -> room.CreateInviteCodeRequest
+	24, // 27: room.RoomService.ListInviteCodes:input_type -> room.ListInviteCodesRequest
+	26, // 28: room.RoomService.RevokeInviteCode:input_type -> room.RevokeInviteCodeRequest
+	28, // 29: room.RoomService.JoinRoomByInvite:input_type -> room.JoinRoomByInviteRequest
+	30, // 30: room.RoomService.KickMember:input_type -> room.KickMemberRequest
+	32, // 31: room.RoomService.BanMember:input_type -> room.BanMemberRequest
+	34, // 32: room.RoomService.UnbanMember:input_type -> room.UnbanMemberRequest
+	36, // 33: room.RoomService.MuteMember:input_type -> room.MuteMemberRequest
+	38, // 34: room.RoomService.UnmuteMember:input_type -> room.UnmuteMemberRequest
+	40, // 35: room.RoomService.UpdateRoom:input_type -> room.UpdateRoomRequest
+	41, // 36: room.RoomService.ArchiveRoom:input_type -> room.ArchiveRoomRequest
+	43, // 37: room.RoomService.UnarchiveRoom:input_type -> room.UnarchiveRoomRequest
+	45, // 38: room.RoomService.DeleteRoom:input_type -> room.DeleteRoomRequest
+	47, // 39: room.RoomService.SearchRooms:input_type -> room.SearchRoomsRequest
+	50, // 40: room.RoomService.GetRoom:input_type -> room.GetRoomRequest
+	52, // 41: room.RoomService.ListRoomMembers:input_type -> room.ListRoomMembersRequest
+	55, // 42: room.RoomService.ListJoinRequests:input_type -> room.ListJoinRequestsRequest
+	57, // 43: room.RoomService.GetJoinRequests:input_type -> room.GetJoinRequestsRequest
+	59, // 44: room.RoomService.ApproveJoinRequest:input_type -> room.ApproveJoinRequestRequest
+	61, // 45: room.RoomService.RejectJoinRequest:input_type -> room.RejectJoinRequestRequest
+	64, // 46: room.RoomService.OpenDirectConversation:input_type -> room.OpenDirectConversationRequest
+	68, // 47: room.RoomService.CreateGroupConversation:input_type -> room.CreateGroupConversationRequest
+	70, // 48: room.RoomService.AddGroupConversationMembers:input_type -> room.AddGroupConversationMembersRequest
+	1,  // 49: room.RoomService.CreateRoom:output_type -> room.RoomResponse
+	3,  // 50: room.RoomService.GetRooms:output_type -> room.GetRoomsResponse
+	5,  // 51: room.RoomService.JoinRoom:output_type -> room.JoinRoomResponse
+	7,  // 52: room.RoomService.LeaveRoom:output_type -> room.LeaveRoomResponse
+	9,  // 53: room.RoomService.SetMemberRole:output_type -> room.SetMemberRoleResponse
+	11, // 54: room.RoomService.TransferOwnership:output_type -> room.TransferOwnershipResponse
+	13, // 55: room.RoomService.InviteUser:output_type -> room.InviteUserResponse
+	16, // 56: room.RoomService.GetInvites:output_type -> room.GetInvitesResponse
+	18, // 57: room.RoomService.AcceptInvite:output_type -> room.AcceptInviteResponse
+	20, // 58: room.RoomService.DeclineInvite:output_type -> room.DeclineInviteResponse
+	23, // 59: room.RoomService.CreateInviteCode:output_type -> room.InviteCode
+	25, // 60: room.RoomService.ListInviteCodes:output_type -> room.ListInviteCodesResponse
+	27, // 61: room.RoomService.RevokeInviteCode:output_type -> room.RevokeInviteCodeResponse
+	29, // 62: room.RoomService.JoinRoomByInvite:output_type -> room.JoinRoomByInviteResponse
+	31, // 63: room.RoomService.KickMember:output_type -> room.KickMemberResponse
+	33, // 64: room.RoomService.BanMember:output_type -> room.BanMemberResponse
+	35, // 65: room.RoomService.UnbanMember:output_type -> room.UnbanMemberResponse
+	37, // 66: room.RoomService.MuteMember:output_type -> room.MuteMemberResponse
+	39, // 67: room.RoomService.UnmuteMember:output_type -> room.UnmuteMemberResponse
+	1,  // 68: room.RoomService.UpdateRoom:output_type -> room.RoomResponse
+	42, // 69: room.RoomService.ArchiveRoom:output_type -> room.ArchiveRoomResponse
+	44, // 70: room.RoomService.UnarchiveRoom:output_type -> room.UnarchiveRoomResponse
+	46, // 71: room.RoomService.DeleteRoom:output_type -> room.DeleteRoomResponse
+	48, // 72: room.RoomService.SearchRooms:output_type -> room.SearchRoomsResponse
+	51, // 73: room.RoomService.GetRoom:output_type -> room.GetRoomResponse
+	53, // 74: room.RoomService.ListRoomMembers:output_type -> room.ListRoomMembersResponse
+	56, // 75: room.RoomService.ListJoinRequests:output_type -> room.ListJoinRequestsResponse
+	58, // 76: room.RoomService.GetJoinRequests:output_type -> room.GetJoinRequestsResponse
+	60, // 77: room.RoomService.ApproveJoinRequest:output_type -> room.ApproveJoinRequestResponse
+	62, // 78: room.RoomService.RejectJoinRequest:output_type -> room.RejectJoinRequestResponse
+	65, // 79: room.RoomService.OpenDirectConversation:output_type -> room.OpenDirectConversationResponse
+	69, // 80: room.RoomService.CreateGroupConversation:output_type -> room.CreateGroupConversationResponse
+	71, // 81: room.RoomService.AddGroupConversationMembers:output_type -> room.AddGroupConversationMembersResponse
+	49, // [49:82] is the sub-list for method output_type
+	16, // [16:49] is the sub-list for method input_type
+	16, // [16:16] is the sub-list for extension type_name
+	16, // [16:16] is the sub-list for extension extendee
+	0,  // [0:16] is the sub-list for field type_name
 }
 
 func init() { file_proto_room_room_proto_init() }
@@ -538,13 +4790,18 @@ func file_proto_room_room_proto_init() {
 	if File_proto_room_room_proto != nil {
 		return
 	}
+	file_proto_room_room_proto_msgTypes[0].OneofWrappers = []any{}
+	file_proto_room_room_proto_msgTypes[2].OneofWrappers = []any{}
+	file_proto_room_room_proto_msgTypes[4].OneofWrappers = []any{}
+	file_proto_room_room_proto_msgTypes[6].OneofWrappers = []any{}
+	file_proto_room_room_proto_msgTypes[40].OneofWrappers = []any{}
 	type x struct{}
 	out := protoimpl.TypeBuilder{
 		File: protoimpl.DescBuilder{
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: unsafe.Slice(unsafe.StringData(file_proto_room_room_proto_rawDesc), len(file_proto_room_room_proto_rawDesc)),
 			NumEnums:      0,
-			NumMessages:   8,
+			NumMessages:   72,
 			NumExtensions: 0,
 			NumServices:   1,
 		},
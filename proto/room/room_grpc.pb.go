@@ -19,10 +19,39 @@ import (
 const _ = grpc.SupportPackageIsVersion9
 
 const (
-	RoomService_CreateRoom_FullMethodName = "/room.RoomService/CreateRoom"
-	RoomService_GetRooms_FullMethodName   = "/room.RoomService/GetRooms"
-	RoomService_JoinRoom_FullMethodName   = "/room.RoomService/JoinRoom"
-	RoomService_LeaveRoom_FullMethodName  = "/room.RoomService/LeaveRoom"
+	RoomService_CreateRoom_FullMethodName                  = "/room.RoomService/CreateRoom"
+	RoomService_GetRooms_FullMethodName                    = "/room.RoomService/GetRooms"
+	RoomService_JoinRoom_FullMethodName                    = "/room.RoomService/JoinRoom"
+	RoomService_LeaveRoom_FullMethodName                   = "/room.RoomService/LeaveRoom"
+	RoomService_SetMemberRole_FullMethodName               = "/room.RoomService/SetMemberRole"
+	RoomService_TransferOwnership_FullMethodName           = "/room.RoomService/TransferOwnership"
+	RoomService_InviteUser_FullMethodName                  = "/room.RoomService/InviteUser"
+	RoomService_GetInvites_FullMethodName                  = "/room.RoomService/GetInvites"
+	RoomService_AcceptInvite_FullMethodName                = "/room.RoomService/AcceptInvite"
+	RoomService_DeclineInvite_FullMethodName               = "/room.RoomService/DeclineInvite"
+	RoomService_CreateInviteCode_FullMethodName            = "/room.RoomService/CreateInviteCode"
+	RoomService_ListInviteCodes_FullMethodName             = "/room.RoomService/ListInviteCodes"
+	RoomService_RevokeInviteCode_FullMethodName            = "/room.RoomService/RevokeInviteCode"
+	RoomService_JoinRoomByInvite_FullMethodName            = "/room.RoomService/JoinRoomByInvite"
+	RoomService_KickMember_FullMethodName                  = "/room.RoomService/KickMember"
+	RoomService_BanMember_FullMethodName                   = "/room.RoomService/BanMember"
+	RoomService_UnbanMember_FullMethodName                 = "/room.RoomService/UnbanMember"
+	RoomService_MuteMember_FullMethodName                  = "/room.RoomService/MuteMember"
+	RoomService_UnmuteMember_FullMethodName                = "/room.RoomService/UnmuteMember"
+	RoomService_UpdateRoom_FullMethodName                  = "/room.RoomService/UpdateRoom"
+	RoomService_ArchiveRoom_FullMethodName                 = "/room.RoomService/ArchiveRoom"
+	RoomService_UnarchiveRoom_FullMethodName               = "/room.RoomService/UnarchiveRoom"
+	RoomService_DeleteRoom_FullMethodName                  = "/room.RoomService/DeleteRoom"
+	RoomService_SearchRooms_FullMethodName                 = "/room.RoomService/SearchRooms"
+	RoomService_GetRoom_FullMethodName                     = "/room.RoomService/GetRoom"
+	RoomService_ListRoomMembers_FullMethodName             = "/room.RoomService/ListRoomMembers"
+	RoomService_ListJoinRequests_FullMethodName            = "/room.RoomService/ListJoinRequests"
+	RoomService_GetJoinRequests_FullMethodName             = "/room.RoomService/GetJoinRequests"
+	RoomService_ApproveJoinRequest_FullMethodName          = "/room.RoomService/ApproveJoinRequest"
+	RoomService_RejectJoinRequest_FullMethodName           = "/room.RoomService/RejectJoinRequest"
+	RoomService_OpenDirectConversation_FullMethodName      = "/room.RoomService/OpenDirectConversation"
+	RoomService_CreateGroupConversation_FullMethodName     = "/room.RoomService/CreateGroupConversation"
+	RoomService_AddGroupConversationMembers_FullMethodName = "/room.RoomService/AddGroupConversationMembers"
 )
 
 // RoomServiceClient is the client API for RoomService service.
@@ -39,6 +68,67 @@ type RoomServiceClient interface {
 	JoinRoom(ctx context.Context, in *JoinRoomRequest, opts ...grpc.CallOption) (*JoinRoomResponse, error)
 	// LeaveRoom removes a user from a room
 	LeaveRoom(ctx context.Context, in *LeaveRoomRequest, opts ...grpc.CallOption) (*LeaveRoomResponse, error)
+	// SetMemberRole changes the role of a room member
+	SetMemberRole(ctx context.Context, in *SetMemberRoleRequest, opts ...grpc.CallOption) (*SetMemberRoleResponse, error)
+	// TransferOwnership makes another member the owner of a room
+	TransferOwnership(ctx context.Context, in *TransferOwnershipRequest, opts ...grpc.CallOption) (*TransferOwnershipResponse, error)
+	// InviteUser invites a user to join a room
+	InviteUser(ctx context.Context, in *InviteUserRequest, opts ...grpc.CallOption) (*InviteUserResponse, error)
+	// GetInvites retrieves the pending invites of the user
+	GetInvites(ctx context.Context, in *GetInvitesRequest, opts ...grpc.CallOption) (*GetInvitesResponse, error)
+	// AcceptInvite accepts an invite and joins the room
+	AcceptInvite(ctx context.Context, in *AcceptInviteRequest, opts ...grpc.CallOption) (*AcceptInviteResponse, error)
+	// DeclineInvite declines an invite
+	DeclineInvite(ctx context.Context, in *DeclineInviteRequest, opts ...grpc.CallOption) (*DeclineInviteResponse, error)
+	// CreateInviteCode creates a shareable invite code for a room
+	CreateInviteCode(ctx context.Context, in *CreateInviteCodeRequest, opts ...grpc.CallOption) (*InviteCode, error)
+	// ListInviteCodes retrieves the invite codes of a room
+	ListInviteCodes(ctx context.Context, in *ListInviteCodesRequest, opts ...grpc.CallOption) (*ListInviteCodesResponse, error)
+	// RevokeInviteCode stops an invite code from being used
+	RevokeInviteCode(ctx context.Context, in *RevokeInviteCodeRequest, opts ...grpc.CallOption) (*RevokeInviteCodeResponse, error)
+	// JoinRoomByInvite joins the room an invite code belongs to
+	JoinRoomByInvite(ctx context.Context, in *JoinRoomByInviteRequest, opts ...grpc.CallOption) (*JoinRoomByInviteResponse, error)
+	// KickMember removes a member from a room
+	KickMember(ctx context.Context, in *KickMemberRequest, opts ...grpc.CallOption) (*KickMemberResponse, error)
+	// BanMember removes a user from a room and prevents them from rejoining
+	BanMember(ctx context.Context, in *BanMemberRequest, opts ...grpc.CallOption) (*BanMemberResponse, error)
+	// UnbanMember lifts a ban
+	UnbanMember(ctx context.Context, in *UnbanMemberRequest, opts ...grpc.CallOption) (*UnbanMemberResponse, error)
+	// MuteMember prevents a member from sending messages to a room
+	MuteMember(ctx context.Context, in *MuteMemberRequest, opts ...grpc.CallOption) (*MuteMemberResponse, error)
+	// UnmuteMember lifts a mute
+	UnmuteMember(ctx context.Context, in *UnmuteMemberRequest, opts ...grpc.CallOption) (*UnmuteMemberResponse, error)
+	// UpdateRoom changes the details of a room
+	UpdateRoom(ctx context.Context, in *UpdateRoomRequest, opts ...grpc.CallOption) (*RoomResponse, error)
+	// ArchiveRoom makes a room read-only
+	ArchiveRoom(ctx context.Context, in *ArchiveRoomRequest, opts ...grpc.CallOption) (*ArchiveRoomResponse, error)
+	// UnarchiveRoom makes an archived room writable again
+	UnarchiveRoom(ctx context.Context, in *UnarchiveRoomRequest, opts ...grpc.CallOption) (*UnarchiveRoomResponse, error)
+	// DeleteRoom deletes a room with its messages and members
+	DeleteRoom(ctx context.Context, in *DeleteRoomRequest, opts ...grpc.CallOption) (*DeleteRoomResponse, error)
+	// SearchRooms lists public rooms, optionally filtered by a search query
+	SearchRooms(ctx context.Context, in *SearchRoomsRequest, opts ...grpc.CallOption) (*SearchRoomsResponse, error)
+	// GetRoom retrieves a room with its member count and the user's membership
+	GetRoom(ctx context.Context, in *GetRoomRequest, opts ...grpc.CallOption) (*GetRoomResponse, error)
+	// ListRoomMembers retrieves the members of a room
+	ListRoomMembers(ctx context.Context, in *ListRoomMembersRequest, opts ...grpc.CallOption) (*ListRoomMembersResponse, error)
+	// ListJoinRequests retrieves the pending join requests of a room
+	ListJoinRequests(ctx context.Context, in *ListJoinRequestsRequest, opts ...grpc.CallOption) (*ListJoinRequestsResponse, error)
+	// GetJoinRequests retrieves the user's own join requests and their results
+	GetJoinRequests(ctx context.Context, in *GetJoinRequestsRequest, opts ...grpc.CallOption) (*GetJoinRequestsResponse, error)
+	// ApproveJoinRequest adds the requester to the room. The requester is told
+	// with a system message in their direct conversation with the caller.
+	ApproveJoinRequest(ctx context.Context, in *ApproveJoinRequestRequest, opts ...grpc.CallOption) (*ApproveJoinRequestResponse, error)
+	// RejectJoinRequest rejects a join request. The requester is told with a
+	// system message in their direct conversation with the caller.
+	RejectJoinRequest(ctx context.Context, in *RejectJoinRequestRequest, opts ...grpc.CallOption) (*RejectJoinRequestResponse, error)
+	// OpenDirectConversation returns the direct conversation with another user,
+	// creating it on first use
+	OpenDirectConversation(ctx context.Context, in *OpenDirectConversationRequest, opts ...grpc.CallOption) (*OpenDirectConversationResponse, error)
+	// CreateGroupConversation starts an unnamed group conversation
+	CreateGroupConversation(ctx context.Context, in *CreateGroupConversationRequest, opts ...grpc.CallOption) (*CreateGroupConversationResponse, error)
+	// AddGroupConversationMembers adds people to a group conversation
+	AddGroupConversationMembers(ctx context.Context, in *AddGroupConversationMembersRequest, opts ...grpc.CallOption) (*AddGroupConversationMembersResponse, error)
 }
 
 type roomServiceClient struct {
@@ -89,6 +179,296 @@ func (c *roomServiceClient) LeaveRoom(ctx context.Context, in *LeaveRoomRequest,
 	return out, nil
 }
 
+func (c *roomServiceClient) SetMemberRole(ctx context.Context, in *SetMemberRoleRequest, opts ...grpc.CallOption) (*SetMemberRoleResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(SetMemberRoleResponse)
+	err := c.cc.Invoke(ctx, RoomService_SetMemberRole_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *roomServiceClient) TransferOwnership(ctx context.Context, in *TransferOwnershipRequest, opts ...grpc.CallOption) (*TransferOwnershipResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(TransferOwnershipResponse)
+	err := c.cc.Invoke(ctx, RoomService_TransferOwnership_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *roomServiceClient) InviteUser(ctx context.Context, in *InviteUserRequest, opts ...grpc.CallOption) (*InviteUserResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(InviteUserResponse)
+	err := c.cc.Invoke(ctx, RoomService_InviteUser_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *roomServiceClient) GetInvites(ctx context.Context, in *GetInvitesRequest, opts ...grpc.CallOption) (*GetInvitesResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetInvitesResponse)
+	err := c.cc.Invoke(ctx, RoomService_GetInvites_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *roomServiceClient) AcceptInvite(ctx context.Context, in *AcceptInviteRequest, opts ...grpc.CallOption) (*AcceptInviteResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(AcceptInviteResponse)
+	err := c.cc.Invoke(ctx, RoomService_AcceptInvite_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *roomServiceClient) DeclineInvite(ctx context.Context, in *DeclineInviteRequest, opts ...grpc.CallOption) (*DeclineInviteResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(DeclineInviteResponse)
+	err := c.cc.Invoke(ctx, RoomService_DeclineInvite_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *roomServiceClient) CreateInviteCode(ctx context.Context, in *CreateInviteCodeRequest, opts ...grpc.CallOption) (*InviteCode, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(InviteCode)
+	err := c.cc.Invoke(ctx, RoomService_CreateInviteCode_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *roomServiceClient) ListInviteCodes(ctx context.Context, in *ListInviteCodesRequest, opts ...grpc.CallOption) (*ListInviteCodesResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListInviteCodesResponse)
+	err := c.cc.Invoke(ctx, RoomService_ListInviteCodes_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *roomServiceClient) RevokeInviteCode(ctx context.Context, in *RevokeInviteCodeRequest, opts ...grpc.CallOption) (*RevokeInviteCodeResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(RevokeInviteCodeResponse)
+	err := c.cc.Invoke(ctx, RoomService_RevokeInviteCode_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *roomServiceClient) JoinRoomByInvite(ctx context.Context, in *JoinRoomByInviteRequest, opts ...grpc.CallOption) (*JoinRoomByInviteResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(JoinRoomByInviteResponse)
+	err := c.cc.Invoke(ctx, RoomService_JoinRoomByInvite_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *roomServiceClient) KickMember(ctx context.Context, in *KickMemberRequest, opts ...grpc.CallOption) (*KickMemberResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(KickMemberResponse)
+	err := c.cc.Invoke(ctx, RoomService_KickMember_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *roomServiceClient) BanMember(ctx context.Context, in *BanMemberRequest, opts ...grpc.CallOption) (*BanMemberResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(BanMemberResponse)
+	err := c.cc.Invoke(ctx, RoomService_BanMember_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *roomServiceClient) UnbanMember(ctx context.Context, in *UnbanMemberRequest, opts ...grpc.CallOption) (*UnbanMemberResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(UnbanMemberResponse)
+	err := c.cc.Invoke(ctx, RoomService_UnbanMember_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *roomServiceClient) MuteMember(ctx context.Context, in *MuteMemberRequest, opts ...grpc.CallOption) (*MuteMemberResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(MuteMemberResponse)
+	err := c.cc.Invoke(ctx, RoomService_MuteMember_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *roomServiceClient) UnmuteMember(ctx context.Context, in *UnmuteMemberRequest, opts ...grpc.CallOption) (*UnmuteMemberResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(UnmuteMemberResponse)
+	err := c.cc.Invoke(ctx, RoomService_UnmuteMember_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *roomServiceClient) UpdateRoom(ctx context.Context, in *UpdateRoomRequest, opts ...grpc.CallOption) (*RoomResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(RoomResponse)
+	err := c.cc.Invoke(ctx, RoomService_UpdateRoom_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *roomServiceClient) ArchiveRoom(ctx context.Context, in *ArchiveRoomRequest, opts ...grpc.CallOption) (*ArchiveRoomResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ArchiveRoomResponse)
+	err := c.cc.Invoke(ctx, RoomService_ArchiveRoom_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *roomServiceClient) UnarchiveRoom(ctx context.Context, in *UnarchiveRoomRequest, opts ...grpc.CallOption) (*UnarchiveRoomResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(UnarchiveRoomResponse)
+	err := c.cc.Invoke(ctx, RoomService_UnarchiveRoom_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *roomServiceClient) DeleteRoom(ctx context.Context, in *DeleteRoomRequest, opts ...grpc.CallOption) (*DeleteRoomResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(DeleteRoomResponse)
+	err := c.cc.Invoke(ctx, RoomService_DeleteRoom_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *roomServiceClient) SearchRooms(ctx context.Context, in *SearchRoomsRequest, opts ...grpc.CallOption) (*SearchRoomsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(SearchRoomsResponse)
+	err := c.cc.Invoke(ctx, RoomService_SearchRooms_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *roomServiceClient) GetRoom(ctx context.Context, in *GetRoomRequest, opts ...grpc.CallOption) (*GetRoomResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetRoomResponse)
+	err := c.cc.Invoke(ctx, RoomService_GetRoom_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *roomServiceClient) ListRoomMembers(ctx context.Context, in *ListRoomMembersRequest, opts ...grpc.CallOption) (*ListRoomMembersResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListRoomMembersResponse)
+	err := c.cc.Invoke(ctx, RoomService_ListRoomMembers_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *roomServiceClient) ListJoinRequests(ctx context.Context, in *ListJoinRequestsRequest, opts ...grpc.CallOption) (*ListJoinRequestsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListJoinRequestsResponse)
+	err := c.cc.Invoke(ctx, RoomService_ListJoinRequests_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *roomServiceClient) GetJoinRequests(ctx context.Context, in *GetJoinRequestsRequest, opts ...grpc.CallOption) (*GetJoinRequestsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetJoinRequestsResponse)
+	err := c.cc.Invoke(ctx, RoomService_GetJoinRequests_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *roomServiceClient) ApproveJoinRequest(ctx context.Context, in *ApproveJoinRequestRequest, opts ...grpc.CallOption) (*ApproveJoinRequestResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ApproveJoinRequestResponse)
+	err := c.cc.Invoke(ctx, RoomService_ApproveJoinRequest_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *roomServiceClient) RejectJoinRequest(ctx context.Context, in *RejectJoinRequestRequest, opts ...grpc.CallOption) (*RejectJoinRequestResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(RejectJoinRequestResponse)
+	err := c.cc.Invoke(ctx, RoomService_RejectJoinRequest_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *roomServiceClient) OpenDirectConversation(ctx context.Context, in *OpenDirectConversationRequest, opts ...grpc.CallOption) (*OpenDirectConversationResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(OpenDirectConversationResponse)
+	err := c.cc.Invoke(ctx, RoomService_OpenDirectConversation_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *roomServiceClient) CreateGroupConversation(ctx context.Context, in *CreateGroupConversationRequest, opts ...grpc.CallOption) (*CreateGroupConversationResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(CreateGroupConversationResponse)
+	err := c.cc.Invoke(ctx, RoomService_CreateGroupConversation_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *roomServiceClient) AddGroupConversationMembers(ctx context.Context, in *AddGroupConversationMembersRequest, opts ...grpc.CallOption) (*AddGroupConversationMembersResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(AddGroupConversationMembersResponse)
+	err := c.cc.Invoke(ctx, RoomService_AddGroupConversationMembers_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // RoomServiceServer is the server API for RoomService service.
 // All implementations must embed UnimplementedRoomServiceServer
 // for forward compatibility.
@@ -103,6 +483,67 @@ type RoomServiceServer interface {
 	JoinRoom(context.Context, *JoinRoomRequest) (*JoinRoomResponse, error)
 	// LeaveRoom removes a user from a room
 	LeaveRoom(context.Context, *LeaveRoomRequest) (*LeaveRoomResponse, error)
+	// SetMemberRole changes the role of a room member
+	SetMemberRole(context.Context, *SetMemberRoleRequest) (*SetMemberRoleResponse, error)
+	// TransferOwnership makes another member the owner of a room
+	TransferOwnership(context.Context, *TransferOwnershipRequest) (*TransferOwnershipResponse, error)
+	// InviteUser invites a user to join a room
+	InviteUser(context.Context, *InviteUserRequest) (*InviteUserResponse, error)
+	// GetInvites retrieves the pending invites of the user
+	GetInvites(context.Context, *GetInvitesRequest) (*GetInvitesResponse, error)
+	// AcceptInvite accepts an invite and joins the room
+	AcceptInvite(context.Context, *AcceptInviteRequest) (*AcceptInviteResponse, error)
+	// DeclineInvite declines an invite
+	DeclineInvite(context.Context, *DeclineInviteRequest) (*DeclineInviteResponse, error)
+	// CreateInviteCode creates a shareable invite code for a room
+	CreateInviteCode(context.Context, *CreateInviteCodeRequest) (*InviteCode, error)
+	// ListInviteCodes retrieves the invite codes of a room
+	ListInviteCodes(context.Context, *ListInviteCodesRequest) (*ListInviteCodesResponse, error)
+	// RevokeInviteCode stops an invite code from being used
+	RevokeInviteCode(context.Context, *RevokeInviteCodeRequest) (*RevokeInviteCodeResponse, error)
+	// JoinRoomByInvite joins the room an invite code belongs to
+	JoinRoomByInvite(context.Context, *JoinRoomByInviteRequest) (*JoinRoomByInviteResponse, error)
+	// KickMember removes a member from a room
+	KickMember(context.Context, *KickMemberRequest) (*KickMemberResponse, error)
+	// BanMember removes a user from a room and prevents them from rejoining
+	BanMember(context.Context, *BanMemberRequest) (*BanMemberResponse, error)
+	// UnbanMember lifts a ban
+	UnbanMember(context.Context, *UnbanMemberRequest) (*UnbanMemberResponse, error)
+	// MuteMember prevents a member from sending messages to a room
+	MuteMember(context.Context, *MuteMemberRequest) (*MuteMemberResponse, error)
+	// UnmuteMember lifts a mute
+	UnmuteMember(context.Context, *UnmuteMemberRequest) (*UnmuteMemberResponse, error)
+	// UpdateRoom changes the details of a room
+	UpdateRoom(context.Context, *UpdateRoomRequest) (*RoomResponse, error)
+	// ArchiveRoom makes a room read-only
+	ArchiveRoom(context.Context, *ArchiveRoomRequest) (*ArchiveRoomResponse, error)
+	// UnarchiveRoom makes an archived room writable again
+	UnarchiveRoom(context.Context, *UnarchiveRoomRequest) (*UnarchiveRoomResponse, error)
+	// DeleteRoom deletes a room with its messages and members
+	DeleteRoom(context.Context, *DeleteRoomRequest) (*DeleteRoomResponse, error)
+	// SearchRooms lists public rooms, optionally filtered by a search query
+	SearchRooms(context.Context, *SearchRoomsRequest) (*SearchRoomsResponse, error)
+	// GetRoom retrieves a room with its member count and the user's membership
+	GetRoom(context.Context, *GetRoomRequest) (*GetRoomResponse, error)
+	// ListRoomMembers retrieves the members of a room
+	ListRoomMembers(context.Context, *ListRoomMembersRequest) (*ListRoomMembersResponse, error)
+	// ListJoinRequests retrieves the pending join requests of a room
+	ListJoinRequests(context.Context, *ListJoinRequestsRequest) (*ListJoinRequestsResponse, error)
+	// GetJoinRequests retrieves the user's own join requests and their results
+	GetJoinRequests(context.Context, *GetJoinRequestsRequest) (*GetJoinRequestsResponse, error)
+	// ApproveJoinRequest adds the requester to the room. The requester is told
+	// with a system message in their direct conversation with the caller.
+	ApproveJoinRequest(context.Context, *ApproveJoinRequestRequest) (*ApproveJoinRequestResponse, error)
+	// RejectJoinRequest rejects a join request. The requester is told with a
+	// system message in their direct conversation with the caller.
+	RejectJoinRequest(context.Context, *RejectJoinRequestRequest) (*RejectJoinRequestResponse, error)
+	// OpenDirectConversation returns the direct conversation with another user,
+	// creating it on first use
+	OpenDirectConversation(context.Context, *OpenDirectConversationRequest) (*OpenDirectConversationResponse, error)
+	// CreateGroupConversation starts an unnamed group conversation
+	CreateGroupConversation(context.Context, *CreateGroupConversationRequest) (*CreateGroupConversationResponse, error)
+	// AddGroupConversationMembers adds people to a group conversation
+	AddGroupConversationMembers(context.Context, *AddGroupConversationMembersRequest) (*AddGroupConversationMembersResponse, error)
 	mustEmbedUnimplementedRoomServiceServer()
 }
 
@@ -125,6 +566,93 @@ func (UnimplementedRoomServiceServer) JoinRoom(context.Context, *JoinRoomRequest
 func (UnimplementedRoomServiceServer) LeaveRoom(context.Context, *LeaveRoomRequest) (*LeaveRoomResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method LeaveRoom not implemented")
 }
+func (UnimplementedRoomServiceServer) SetMemberRole(context.Context, *SetMemberRoleRequest) (*SetMemberRoleResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetMemberRole not implemented")
+}
+func (UnimplementedRoomServiceServer) TransferOwnership(context.Context, *TransferOwnershipRequest) (*TransferOwnershipResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method TransferOwnership not implemented")
+}
+func (UnimplementedRoomServiceServer) InviteUser(context.Context, *InviteUserRequest) (*InviteUserResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method InviteUser not implemented")
+}
+func (UnimplementedRoomServiceServer) GetInvites(context.Context, *GetInvitesRequest) (*GetInvitesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetInvites not implemented")
+}
+func (UnimplementedRoomServiceServer) AcceptInvite(context.Context, *AcceptInviteRequest) (*AcceptInviteResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AcceptInvite not implemented")
+}
+func (UnimplementedRoomServiceServer) DeclineInvite(context.Context, *DeclineInviteRequest) (*DeclineInviteResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeclineInvite not implemented")
+}
+func (UnimplementedRoomServiceServer) CreateInviteCode(context.Context, *CreateInviteCodeRequest) (*InviteCode, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateInviteCode not implemented")
+}
+func (UnimplementedRoomServiceServer) ListInviteCodes(context.Context, *ListInviteCodesRequest) (*ListInviteCodesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListInviteCodes not implemented")
+}
+func (UnimplementedRoomServiceServer) RevokeInviteCode(context.Context, *RevokeInviteCodeRequest) (*RevokeInviteCodeResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RevokeInviteCode not implemented")
+}
+func (UnimplementedRoomServiceServer) JoinRoomByInvite(context.Context, *JoinRoomByInviteRequest) (*JoinRoomByInviteResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method JoinRoomByInvite not implemented")
+}
+func (UnimplementedRoomServiceServer) KickMember(context.Context, *KickMemberRequest) (*KickMemberResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method KickMember not implemented")
+}
+func (UnimplementedRoomServiceServer) BanMember(context.Context, *BanMemberRequest) (*BanMemberResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method BanMember not implemented")
+}
+func (UnimplementedRoomServiceServer) UnbanMember(context.Context, *UnbanMemberRequest) (*UnbanMemberResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UnbanMember not implemented")
+}
+func (UnimplementedRoomServiceServer) MuteMember(context.Context, *MuteMemberRequest) (*MuteMemberResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method MuteMember not implemented")
+}
+func (UnimplementedRoomServiceServer) UnmuteMember(context.Context, *UnmuteMemberRequest) (*UnmuteMemberResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UnmuteMember not implemented")
+}
+func (UnimplementedRoomServiceServer) UpdateRoom(context.Context, *UpdateRoomRequest) (*RoomResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateRoom not implemented")
+}
+func (UnimplementedRoomServiceServer) ArchiveRoom(context.Context, *ArchiveRoomRequest) (*ArchiveRoomResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ArchiveRoom not implemented")
+}
+func (UnimplementedRoomServiceServer) UnarchiveRoom(context.Context, *UnarchiveRoomRequest) (*UnarchiveRoomResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UnarchiveRoom not implemented")
+}
+func (UnimplementedRoomServiceServer) DeleteRoom(context.Context, *DeleteRoomRequest) (*DeleteRoomResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteRoom not implemented")
+}
+func (UnimplementedRoomServiceServer) SearchRooms(context.Context, *SearchRoomsRequest) (*SearchRoomsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SearchRooms not implemented")
+}
+func (UnimplementedRoomServiceServer) GetRoom(context.Context, *GetRoomRequest) (*GetRoomResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetRoom not implemented")
+}
+func (UnimplementedRoomServiceServer) ListRoomMembers(context.Context, *ListRoomMembersRequest) (*ListRoomMembersResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListRoomMembers not implemented")
+}
+func (UnimplementedRoomServiceServer) ListJoinRequests(context.Context, *ListJoinRequestsRequest) (*ListJoinRequestsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListJoinRequests not implemented")
+}
+func (UnimplementedRoomServiceServer) GetJoinRequests(context.Context, *GetJoinRequestsRequest) (*GetJoinRequestsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetJoinRequests not implemented")
+}
+func (UnimplementedRoomServiceServer) ApproveJoinRequest(context.Context, *ApproveJoinRequestRequest) (*ApproveJoinRequestResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ApproveJoinRequest not implemented")
+}
+func (UnimplementedRoomServiceServer) RejectJoinRequest(context.Context, *RejectJoinRequestRequest) (*RejectJoinRequestResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RejectJoinRequest not implemented")
+}
+func (UnimplementedRoomServiceServer) OpenDirectConversation(context.Context, *OpenDirectConversationRequest) (*OpenDirectConversationResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method OpenDirectConversation not implemented")
+}
+func (UnimplementedRoomServiceServer) CreateGroupConversation(context.Context, *CreateGroupConversationRequest) (*CreateGroupConversationResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateGroupConversation not implemented")
+}
+func (UnimplementedRoomServiceServer) AddGroupConversationMembers(context.Context, *AddGroupConversationMembersRequest) (*AddGroupConversationMembersResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AddGroupConversationMembers not implemented")
+}
 func (UnimplementedRoomServiceServer) mustEmbedUnimplementedRoomServiceServer() {}
 func (UnimplementedRoomServiceServer) testEmbeddedByValue()                     {}
 
@@ -218,28 +746,666 @@ func _RoomService_LeaveRoom_Handler(srv interface{}, ctx context.Context, dec fu
 	return interceptor(ctx, in, info, handler)
 }
 
-// RoomService_ServiceDesc is the grpc.ServiceDesc for RoomService service.
-// It's only intended for direct use with grpc.RegisterService,
-// and not to be introspected or modified (even as a copy)
-var RoomService_ServiceDesc = grpc.ServiceDesc{
-	ServiceName: "room.RoomService",
-	HandlerType: (*RoomServiceServer)(nil),
-	Methods: []grpc.MethodDesc{
-		{
-			MethodName: "CreateRoom",
-			Handler:    _RoomService_CreateRoom_Handler,
-		},
-		{
-			MethodName: "GetRooms",
-			Handler:    _RoomService_GetRooms_Handler,
-		},
-		{
-			MethodName: "JoinRoom",
-			Handler:    _RoomService_JoinRoom_Handler,
-		},
-		{
-			MethodName: "LeaveRoom",
-			Handler:    _RoomService_LeaveRoom_Handler,
+func _RoomService_SetMemberRole_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SetMemberRoleRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RoomServiceServer).SetMemberRole(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: RoomService_SetMemberRole_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RoomServiceServer).SetMemberRole(ctx, req.(*SetMemberRoleRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _RoomService_TransferOwnership_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(TransferOwnershipRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RoomServiceServer).TransferOwnership(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: RoomService_TransferOwnership_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RoomServiceServer).TransferOwnership(ctx, req.(*TransferOwnershipRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _RoomService_InviteUser_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(InviteUserRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RoomServiceServer).InviteUser(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: RoomService_InviteUser_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RoomServiceServer).InviteUser(ctx, req.(*InviteUserRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _RoomService_GetInvites_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetInvitesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RoomServiceServer).GetInvites(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: RoomService_GetInvites_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RoomServiceServer).GetInvites(ctx, req.(*GetInvitesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _RoomService_AcceptInvite_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AcceptInviteRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RoomServiceServer).AcceptInvite(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: RoomService_AcceptInvite_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RoomServiceServer).AcceptInvite(ctx, req.(*AcceptInviteRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _RoomService_DeclineInvite_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeclineInviteRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RoomServiceServer).DeclineInvite(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: RoomService_DeclineInvite_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RoomServiceServer).DeclineInvite(ctx, req.(*DeclineInviteRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _RoomService_CreateInviteCode_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateInviteCodeRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RoomServiceServer).CreateInviteCode(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: RoomService_CreateInviteCode_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RoomServiceServer).CreateInviteCode(ctx, req.(*CreateInviteCodeRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _RoomService_ListInviteCodes_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListInviteCodesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RoomServiceServer).ListInviteCodes(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: RoomService_ListInviteCodes_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RoomServiceServer).ListInviteCodes(ctx, req.(*ListInviteCodesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _RoomService_RevokeInviteCode_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RevokeInviteCodeRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RoomServiceServer).RevokeInviteCode(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: RoomService_RevokeInviteCode_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RoomServiceServer).RevokeInviteCode(ctx, req.(*RevokeInviteCodeRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _RoomService_JoinRoomByInvite_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(JoinRoomByInviteRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RoomServiceServer).JoinRoomByInvite(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: RoomService_JoinRoomByInvite_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RoomServiceServer).JoinRoomByInvite(ctx, req.(*JoinRoomByInviteRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _RoomService_KickMember_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(KickMemberRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RoomServiceServer).KickMember(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: RoomService_KickMember_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RoomServiceServer).KickMember(ctx, req.(*KickMemberRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _RoomService_BanMember_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(BanMemberRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RoomServiceServer).BanMember(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: RoomService_BanMember_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RoomServiceServer).BanMember(ctx, req.(*BanMemberRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _RoomService_UnbanMember_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UnbanMemberRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RoomServiceServer).UnbanMember(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: RoomService_UnbanMember_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RoomServiceServer).UnbanMember(ctx, req.(*UnbanMemberRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _RoomService_MuteMember_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MuteMemberRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RoomServiceServer).MuteMember(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: RoomService_MuteMember_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RoomServiceServer).MuteMember(ctx, req.(*MuteMemberRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _RoomService_UnmuteMember_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UnmuteMemberRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RoomServiceServer).UnmuteMember(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: RoomService_UnmuteMember_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RoomServiceServer).UnmuteMember(ctx, req.(*UnmuteMemberRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _RoomService_UpdateRoom_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateRoomRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RoomServiceServer).UpdateRoom(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: RoomService_UpdateRoom_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RoomServiceServer).UpdateRoom(ctx, req.(*UpdateRoomRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _RoomService_ArchiveRoom_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ArchiveRoomRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RoomServiceServer).ArchiveRoom(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: RoomService_ArchiveRoom_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RoomServiceServer).ArchiveRoom(ctx, req.(*ArchiveRoomRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _RoomService_UnarchiveRoom_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UnarchiveRoomRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RoomServiceServer).UnarchiveRoom(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: RoomService_UnarchiveRoom_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RoomServiceServer).UnarchiveRoom(ctx, req.(*UnarchiveRoomRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _RoomService_DeleteRoom_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteRoomRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RoomServiceServer).DeleteRoom(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: RoomService_DeleteRoom_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RoomServiceServer).DeleteRoom(ctx, req.(*DeleteRoomRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _RoomService_SearchRooms_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SearchRoomsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RoomServiceServer).SearchRooms(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: RoomService_SearchRooms_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RoomServiceServer).SearchRooms(ctx, req.(*SearchRoomsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _RoomService_GetRoom_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetRoomRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RoomServiceServer).GetRoom(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: RoomService_GetRoom_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RoomServiceServer).GetRoom(ctx, req.(*GetRoomRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _RoomService_ListRoomMembers_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListRoomMembersRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RoomServiceServer).ListRoomMembers(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: RoomService_ListRoomMembers_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RoomServiceServer).ListRoomMembers(ctx, req.(*ListRoomMembersRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _RoomService_ListJoinRequests_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListJoinRequestsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RoomServiceServer).ListJoinRequests(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: RoomService_ListJoinRequests_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RoomServiceServer).ListJoinRequests(ctx, req.(*ListJoinRequestsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _RoomService_GetJoinRequests_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetJoinRequestsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RoomServiceServer).GetJoinRequests(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: RoomService_GetJoinRequests_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RoomServiceServer).GetJoinRequests(ctx, req.(*GetJoinRequestsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _RoomService_ApproveJoinRequest_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ApproveJoinRequestRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RoomServiceServer).ApproveJoinRequest(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: RoomService_ApproveJoinRequest_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RoomServiceServer).ApproveJoinRequest(ctx, req.(*ApproveJoinRequestRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _RoomService_RejectJoinRequest_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RejectJoinRequestRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RoomServiceServer).RejectJoinRequest(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: RoomService_RejectJoinRequest_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RoomServiceServer).RejectJoinRequest(ctx, req.(*RejectJoinRequestRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _RoomService_OpenDirectConversation_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(OpenDirectConversationRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RoomServiceServer).OpenDirectConversation(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: RoomService_OpenDirectConversation_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RoomServiceServer).OpenDirectConversation(ctx, req.(*OpenDirectConversationRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _RoomService_CreateGroupConversation_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateGroupConversationRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RoomServiceServer).CreateGroupConversation(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: RoomService_CreateGroupConversation_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RoomServiceServer).CreateGroupConversation(ctx, req.(*CreateGroupConversationRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _RoomService_AddGroupConversationMembers_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AddGroupConversationMembersRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RoomServiceServer).AddGroupConversationMembers(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: RoomService_AddGroupConversationMembers_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RoomServiceServer).AddGroupConversationMembers(ctx, req.(*AddGroupConversationMembersRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+// RoomService_ServiceDesc is the grpc.ServiceDesc for RoomService service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var RoomService_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "room.RoomService",
+	HandlerType: (*RoomServiceServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "CreateRoom",
+			Handler:    _RoomService_CreateRoom_Handler,
+		},
+		{
+			MethodName: "GetRooms",
+			Handler:    _RoomService_GetRooms_Handler,
+		},
+		{
+			MethodName: "JoinRoom",
+			Handler:    _RoomService_JoinRoom_Handler,
+		},
+		{
+			MethodName: "LeaveRoom",
+			Handler:    _RoomService_LeaveRoom_Handler,
+		},
+		{
+			MethodName: "SetMemberRole",
+			Handler:    _RoomService_SetMemberRole_Handler,
+		},
+		{
+			MethodName: "TransferOwnership",
+			Handler:    _RoomService_TransferOwnership_Handler,
+		},
+		{
+			MethodName: "InviteUser",
+			Handler:    _RoomService_InviteUser_Handler,
+		},
+		{
+			MethodName: "GetInvites",
+			Handler:    _RoomService_GetInvites_Handler,
+		},
+		{
+			MethodName: "AcceptInvite",
+			Handler:    _RoomService_AcceptInvite_Handler,
+		},
+		{
+			MethodName: "DeclineInvite",
+			Handler:    _RoomService_DeclineInvite_Handler,
+		},
+		{
+			MethodName: "CreateInviteCode",
+			Handler:    _RoomService_CreateInviteCode_Handler,
+		},
+		{
+			MethodName: "ListInviteCodes",
+			Handler:    _RoomService_ListInviteCodes_Handler,
+		},
+		{
+			MethodName: "RevokeInviteCode",
+			Handler:    _RoomService_RevokeInviteCode_Handler,
+		},
+		{
+			MethodName: "JoinRoomByInvite",
+			Handler:    _RoomService_JoinRoomByInvite_Handler,
+		},
+		{
+			MethodName: "KickMember",
+			Handler:    _RoomService_KickMember_Handler,
+		},
+		{
+			MethodName: "BanMember",
+			Handler:    _RoomService_BanMember_Handler,
+		},
+		{
+			MethodName: "UnbanMember",
+			Handler:    _RoomService_UnbanMember_Handler,
+		},
+		{
+			MethodName: "MuteMember",
+			Handler:    _RoomService_MuteMember_Handler,
+		},
+		{
+			MethodName: "UnmuteMember",
+			Handler:    _RoomService_UnmuteMember_Handler,
+		},
+		{
+			MethodName: "UpdateRoom",
+			Handler:    _RoomService_UpdateRoom_Handler,
+		},
+		{
+			MethodName: "ArchiveRoom",
+			Handler:    _RoomService_ArchiveRoom_Handler,
+		},
+		{
+			MethodName: "UnarchiveRoom",
+			Handler:    _RoomService_UnarchiveRoom_Handler,
+		},
+		{
+			MethodName: "DeleteRoom",
+			Handler:    _RoomService_DeleteRoom_Handler,
+		},
+		{
+			MethodName: "SearchRooms",
+			Handler:    _RoomService_SearchRooms_Handler,
+		},
+		{
+			MethodName: "GetRoom",
+			Handler:    _RoomService_GetRoom_Handler,
+		},
+		{
+			MethodName: "ListRoomMembers",
+			Handler:    _RoomService_ListRoomMembers_Handler,
+		},
+		{
+			MethodName: "ListJoinRequests",
+			Handler:    _RoomService_ListJoinRequests_Handler,
+		},
+		{
+			MethodName: "GetJoinRequests",
+			Handler:    _RoomService_GetJoinRequests_Handler,
+		},
+		{
+			MethodName: "ApproveJoinRequest",
+			Handler:    _RoomService_ApproveJoinRequest_Handler,
+		},
+		{
+			MethodName: "RejectJoinRequest",
+			Handler:    _RoomService_RejectJoinRequest_Handler,
+		},
+		{
+			MethodName: "OpenDirectConversation",
+			Handler:    _RoomService_OpenDirectConversation_Handler,
+		},
+		{
+			MethodName: "CreateGroupConversation",
+			Handler:    _RoomService_CreateGroupConversation_Handler,
+		},
+		{
+			MethodName: "AddGroupConversationMembers",
+			Handler:    _RoomService_AddGroupConversationMembers_Handler,
 		},
 	},
 	Streams:  []grpc.StreamDesc{},
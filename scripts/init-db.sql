@@ -32,8 +32,240 @@ CREATE TABLE IF NOT EXISTS messages (
     created_at TIMESTAMP WITH TIME ZONE DEFAULT CURRENT_TIMESTAMP
 );
 
+-- Create refresh_tokens table
+CREATE TABLE IF NOT EXISTS refresh_tokens (
+    id SERIAL PRIMARY KEY,
+    user_id INTEGER NOT NULL REFERENCES users(id) ON DELETE CASCADE,
+    token_hash VARCHAR(64) NOT NULL UNIQUE,
+    family_id VARCHAR(64) NOT NULL,
+    expires_at TIMESTAMP WITH TIME ZONE NOT NULL,
+    used_at TIMESTAMP WITH TIME ZONE,
+    revoked_at TIMESTAMP WITH TIME ZONE,
+    created_at TIMESTAMP WITH TIME ZONE DEFAULT CURRENT_TIMESTAMP
+);
+
+-- Create revoked_tokens table
+CREATE TABLE IF NOT EXISTS revoked_tokens (
+    token_id VARCHAR(64) PRIMARY KEY,
+    user_id INTEGER NOT NULL REFERENCES users(id) ON DELETE CASCADE,
+    expires_at TIMESTAMP WITH TIME ZONE NOT NULL,
+    revoked_at TIMESTAMP WITH TIME ZONE DEFAULT CURRENT_TIMESTAMP
+);
+
+-- Create room_invites table
+CREATE TABLE IF NOT EXISTS room_invites (
+    id SERIAL PRIMARY KEY,
+    room_id INTEGER NOT NULL REFERENCES rooms(id) ON DELETE CASCADE,
+    inviter_id INTEGER NOT NULL REFERENCES users(id) ON DELETE CASCADE,
+    invitee_id INTEGER NOT NULL REFERENCES users(id) ON DELETE CASCADE,
+    -- 'pending', 'accepted' or 'declined'
+    status VARCHAR(20) NOT NULL DEFAULT 'pending'
+        CHECK (status IN ('pending', 'accepted', 'declined')),
+    expires_at TIMESTAMP WITH TIME ZONE NOT NULL,
+    responded_at TIMESTAMP WITH TIME ZONE,
+    created_at TIMESTAMP WITH TIME ZONE DEFAULT CURRENT_TIMESTAMP
+);
+
+-- Create room_invite_codes table
+CREATE TABLE IF NOT EXISTS room_invite_codes (
+    id SERIAL PRIMARY KEY,
+    code VARCHAR(32) NOT NULL UNIQUE,
+    room_id INTEGER NOT NULL REFERENCES rooms(id) ON DELETE CASCADE,
+    creator_id INTEGER NOT NULL REFERENCES users(id) ON DELETE CASCADE,
+    -- Room role granted on join
+    role VARCHAR(20) NOT NULL DEFAULT 'member'
+        CHECK (role IN ('admin', 'moderator', 'member')),
+    -- 0 means no limit
+    max_uses INTEGER NOT NULL DEFAULT 0,
+    uses INTEGER NOT NULL DEFAULT 0,
+    expires_at TIMESTAMP WITH TIME ZONE,
+    revoked_at TIMESTAMP WITH TIME ZONE,
+    created_at TIMESTAMP WITH TIME ZONE DEFAULT CURRENT_TIMESTAMP
+);
+
+-- Create room_invite_code_uses table
+CREATE TABLE IF NOT EXISTS room_invite_code_uses (
+    code_id INTEGER NOT NULL REFERENCES room_invite_codes(id) ON DELETE CASCADE,
+    user_id INTEGER NOT NULL REFERENCES users(id) ON DELETE CASCADE,
+    used_at TIMESTAMP WITH TIME ZONE DEFAULT CURRENT_TIMESTAMP,
+    PRIMARY KEY (code_id, user_id)
+);
+
+-- Create room_bans table
+CREATE TABLE IF NOT EXISTS room_bans (
+    room_id INTEGER NOT NULL REFERENCES rooms(id) ON DELETE CASCADE,
+    user_id INTEGER NOT NULL REFERENCES users(id) ON DELETE CASCADE,
+    banned_by INTEGER REFERENCES users(id) ON DELETE SET NULL,
+    reason TEXT NOT NULL DEFAULT '',
+    -- NULL means the ban is permanent
+    expires_at TIMESTAMP WITH TIME ZONE,
+    created_at TIMESTAMP WITH TIME ZONE DEFAULT CURRENT_TIMESTAMP,
+    PRIMARY KEY (room_id, user_id)
+);
+
+-- Create room_mutes table
+CREATE TABLE IF NOT EXISTS room_mutes (
+    room_id INTEGER NOT NULL REFERENCES rooms(id) ON DELETE CASCADE,
+    user_id INTEGER NOT NULL REFERENCES users(id) ON DELETE CASCADE,
+    muted_by INTEGER REFERENCES users(id) ON DELETE SET NULL,
+    reason TEXT NOT NULL DEFAULT '',
+    -- NULL means the user stays muted until unmuted
+    expires_at TIMESTAMP WITH TIME ZONE,
+    created_at TIMESTAMP WITH TIME ZONE DEFAULT CURRENT_TIMESTAMP,
+    PRIMARY KEY (room_id, user_id)
+);
+
+-- Create room_join_requests table
+CREATE TABLE IF NOT EXISTS room_join_requests (
+    id SERIAL PRIMARY KEY,
+    room_id INTEGER NOT NULL REFERENCES rooms(id) ON DELETE CASCADE,
+    user_id INTEGER NOT NULL REFERENCES users(id) ON DELETE CASCADE,
+    message TEXT NOT NULL DEFAULT '',
+    -- 'pending', 'approved' or 'rejected'
+    status VARCHAR(20) NOT NULL DEFAULT 'pending'
+        CHECK (status IN ('pending', 'approved', 'rejected')),
+    decided_by INTEGER REFERENCES users(id) ON DELETE SET NULL,
+    decided_at TIMESTAMP WITH TIME ZONE,
+    created_at TIMESTAMP WITH TIME ZONE DEFAULT CURRENT_TIMESTAMP
+);
+
+-- Create direct_conversations table. Each pair of users has at most one
+-- direct conversation, stored with the lower user ID first.
+CREATE TABLE IF NOT EXISTS direct_conversations (
+    room_id INTEGER PRIMARY KEY REFERENCES rooms(id) ON DELETE CASCADE,
+    user_low INTEGER NOT NULL REFERENCES users(id) ON DELETE CASCADE,
+    user_high INTEGER NOT NULL REFERENCES users(id) ON DELETE CASCADE,
+    CHECK (user_low < user_high),
+    UNIQUE (user_low, user_high)
+);
+
+-- Create message_revisions table. Each row is content a message had before
+-- it was edited or deleted.
+CREATE TABLE IF NOT EXISTS message_revisions (
+    id SERIAL PRIMARY KEY,
+    message_id INTEGER NOT NULL REFERENCES messages(id) ON DELETE CASCADE,
+    content TEXT NOT NULL,
+    replaced_by INTEGER REFERENCES users(id) ON DELETE SET NULL,
+    replaced_at TIMESTAMP WITH TIME ZONE DEFAULT CURRENT_TIMESTAMP
+);
+
+-- Create message_reactions table. A user adds a given emoji to a message at
+-- most once.
+CREATE TABLE IF NOT EXISTS message_reactions (
+    message_id INTEGER NOT NULL REFERENCES messages(id) ON DELETE CASCADE,
+    user_id INTEGER NOT NULL REFERENCES users(id) ON DELETE CASCADE,
+    emoji VARCHAR(64) NOT NULL,
+    created_at TIMESTAMP WITH TIME ZONE DEFAULT CURRENT_TIMESTAMP,
+    PRIMARY KEY (message_id, user_id, emoji)
+);
+
+-- Create room_viewers table. Each chat service instance keeps a row for every
+-- user with streams open on a room, refreshed while they stay open, so users
+-- are online in a room while any instance has a fresh row for them.
+CREATE TABLE IF NOT EXISTS room_viewers (
+    room_id INTEGER NOT NULL REFERENCES rooms(id) ON DELETE CASCADE,
+    user_id INTEGER NOT NULL REFERENCES users(id) ON DELETE CASCADE,
+    instance_id VARCHAR(32) NOT NULL,
+    seen_at TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    PRIMARY KEY (room_id, user_id, instance_id)
+);
+
+-- Add columns introduced after the initial schema
+ALTER TABLE users ADD COLUMN IF NOT EXISTS token_version INTEGER NOT NULL DEFAULT 0;
+-- Site role: 'user', 'moderator' or 'admin'. Grant the first admin with
+-- UPDATE users SET role = 'admin' WHERE username = '...';
+ALTER TABLE users ADD COLUMN IF NOT EXISTS role VARCHAR(20) NOT NULL DEFAULT 'user'
+    CHECK (role IN ('user', 'moderator', 'admin'));
+ALTER TABLE users ADD COLUMN IF NOT EXISTS disabled BOOLEAN NOT NULL DEFAULT FALSE;
+-- Refreshed while the user has an open chat stream
+ALTER TABLE users ADD COLUMN IF NOT EXISTS last_seen_at TIMESTAMP WITH TIME ZONE;
+-- Room role: 'owner', 'admin', 'moderator' or 'member'
+ALTER TABLE room_members ADD COLUMN IF NOT EXISTS role VARCHAR(20) NOT NULL DEFAULT 'member'
+    CHECK (role IN ('owner', 'admin', 'moderator', 'member'));
+
+-- Private rooms can only be joined with an invite
+ALTER TABLE rooms ADD COLUMN IF NOT EXISTS is_private BOOLEAN NOT NULL DEFAULT FALSE;
+
+-- Room details, archival and soft deletion
+ALTER TABLE rooms ADD COLUMN IF NOT EXISTS topic TEXT NOT NULL DEFAULT '';
+ALTER TABLE rooms ADD COLUMN IF NOT EXISTS avatar_url TEXT NOT NULL DEFAULT '';
+ALTER TABLE rooms ADD COLUMN IF NOT EXISTS archived_at TIMESTAMP WITH TIME ZONE;
+ALTER TABLE rooms ADD COLUMN IF NOT EXISTS deleted_at TIMESTAMP WITH TIME ZONE;
+
+-- Room capacity and join policy: 'open', 'approval' or 'invite_only'
+ALTER TABLE rooms ADD COLUMN IF NOT EXISTS max_members INTEGER CHECK (max_members > 0);
+ALTER TABLE rooms ADD COLUMN IF NOT EXISTS join_policy VARCHAR(20)
+    CHECK (join_policy IN ('open', 'approval', 'invite_only'));
+UPDATE rooms SET join_policy = CASE WHEN is_private THEN 'invite_only' ELSE 'open' END
+WHERE join_policy IS NULL;
+ALTER TABLE rooms ALTER COLUMN join_policy SET DEFAULT 'open';
+ALTER TABLE rooms ALTER COLUMN join_policy SET NOT NULL;
+
+-- Edited and deleted messages. Deleted messages keep their row as a
+-- tombstone with empty content.
+ALTER TABLE messages ADD COLUMN IF NOT EXISTS edited_at TIMESTAMP WITH TIME ZONE;
+ALTER TABLE messages ADD COLUMN IF NOT EXISTS deleted_at TIMESTAMP WITH TIME ZONE;
+ALTER TABLE messages ADD COLUMN IF NOT EXISTS deleted_by INTEGER REFERENCES users(id) ON DELETE SET NULL;
+-- Replies point to the first message of their thread, which keeps a count
+-- of its replies and the time of the latest one
+ALTER TABLE messages ADD COLUMN IF NOT EXISTS parent_id INTEGER REFERENCES messages(id) ON DELETE CASCADE;
+ALTER TABLE messages ADD COLUMN IF NOT EXISTS reply_count INTEGER NOT NULL DEFAULT 0;
+ALTER TABLE messages ADD COLUMN IF NOT EXISTS last_reply_at TIMESTAMP WITH TIME ZONE;
+-- Inline quote replies point to the message quoted. Forwarded messages keep
+-- the room and sender of the original message.
+ALTER TABLE messages ADD COLUMN IF NOT EXISTS reply_to_id INTEGER REFERENCES messages(id) ON DELETE SET NULL;
+ALTER TABLE messages ADD COLUMN IF NOT EXISTS forwarded_from_room_id INTEGER REFERENCES rooms(id) ON DELETE SET NULL;
+ALTER TABLE messages ADD COLUMN IF NOT EXISTS forwarded_from_sender_id INTEGER REFERENCES users(id) ON DELETE SET NULL;
+-- Messages are numbered within their room in commit order, which streams
+-- resume from. Inserting a message bumps the room's counter, keeping the room
+-- row locked until the transaction ends, so numbers are handed out in the
+-- order messages commit and a rolled back insert gives its number back.
+ALTER TABLE rooms ADD COLUMN IF NOT EXISTS message_seq BIGINT NOT NULL DEFAULT 0;
+ALTER TABLE messages ADD COLUMN IF NOT EXISTS room_seq BIGINT;
+UPDATE messages m SET room_seq = numbered.seq
+FROM (SELECT id, ROW_NUMBER() OVER (PARTITION BY room_id ORDER BY id) AS seq FROM messages) numbered
+WHERE m.id = numbered.id AND m.room_seq IS NULL;
+UPDATE rooms r SET message_seq = (SELECT MAX(room_seq) FROM messages m WHERE m.room_id = r.id)
+WHERE r.message_seq = 0 AND EXISTS (SELECT 1 FROM messages m WHERE m.room_id = r.id);
+CREATE OR REPLACE FUNCTION assign_message_room_seq() RETURNS trigger AS $$
+BEGIN
+    UPDATE rooms SET message_seq = message_seq + 1 WHERE id = NEW.room_id
+    RETURNING message_seq INTO NEW.room_seq;
+    RETURN NEW;
+END;
+$$ LANGUAGE plpgsql;
+DROP TRIGGER IF EXISTS messages_room_seq ON messages;
+CREATE TRIGGER messages_room_seq BEFORE INSERT ON messages
+    FOR EACH ROW EXECUTE FUNCTION assign_message_room_seq();
+
+-- Room kind: 'room', 'direct' or 'group'
+ALTER TABLE rooms ADD COLUMN IF NOT EXISTS kind VARCHAR(20) NOT NULL DEFAULT 'room';
+ALTER TABLE rooms DROP CONSTRAINT IF EXISTS rooms_kind_check;
+ALTER TABLE rooms ADD CONSTRAINT rooms_kind_check CHECK (kind IN ('room', 'direct', 'group'));
+
+-- Rooms created before room roles are owned by their creator
+UPDATE room_members rm SET role = 'owner'
+FROM rooms r
+WHERE rm.room_id = r.id AND rm.user_id = r.creator_id AND rm.role = 'member'
+    AND NOT EXISTS (SELECT 1 FROM room_members o WHERE o.room_id = r.id AND o.role = 'owner');
+
 -- Create indexes
 CREATE INDEX IF NOT EXISTS idx_room_members_room_id ON room_members(room_id);
 CREATE INDEX IF NOT EXISTS idx_room_members_user_id ON room_members(user_id);
 CREATE INDEX IF NOT EXISTS idx_messages_room_id ON messages(room_id);
 CREATE INDEX IF NOT EXISTS idx_messages_sender_id ON messages(sender_id);
+CREATE INDEX IF NOT EXISTS idx_refresh_tokens_user_id ON refresh_tokens(user_id);
+CREATE INDEX IF NOT EXISTS idx_refresh_tokens_family_id ON refresh_tokens(family_id);
+CREATE INDEX IF NOT EXISTS idx_revoked_tokens_expires_at ON revoked_tokens(expires_at);
+CREATE UNIQUE INDEX IF NOT EXISTS idx_room_members_owner ON room_members(room_id) WHERE role = 'owner';
+CREATE INDEX IF NOT EXISTS idx_room_invites_invitee_id ON room_invites(invitee_id);
+CREATE UNIQUE INDEX IF NOT EXISTS idx_room_invites_pending ON room_invites(room_id, invitee_id) WHERE status = 'pending';
+CREATE INDEX IF NOT EXISTS idx_room_invite_codes_room_id ON room_invite_codes(room_id);
+CREATE INDEX IF NOT EXISTS idx_rooms_deleted_at ON rooms(deleted_at) WHERE deleted_at IS NOT NULL;
+CREATE INDEX IF NOT EXISTS idx_messages_room_id_created_at ON messages(room_id, created_at);
+CREATE INDEX IF NOT EXISTS idx_room_join_requests_user_id ON room_join_requests(user_id);
+CREATE UNIQUE INDEX IF NOT EXISTS idx_room_join_requests_pending ON room_join_requests(room_id, user_id) WHERE status = 'pending';
+CREATE INDEX IF NOT EXISTS idx_direct_conversations_user_high ON direct_conversations(user_high);
+CREATE INDEX IF NOT EXISTS idx_message_revisions_message_id ON message_revisions(message_id);
+CREATE INDEX IF NOT EXISTS idx_messages_parent_id ON messages(parent_id) WHERE parent_id IS NOT NULL;
+CREATE UNIQUE INDEX IF NOT EXISTS idx_messages_room_seq ON messages(room_id, room_seq);
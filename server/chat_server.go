@@ -8,10 +8,10 @@ import (
 	"time"
 
 	"grpc-messenger-core/db"
+	"grpc-messenger-core/internal/middleware"
 	pb "grpc-messenger-core/proto"
 
 	"google.golang.org/grpc/codes"
-	"google.golang.org/grpc/metadata"
 	"google.golang.org/grpc/status"
 )
 
@@ -33,14 +33,14 @@ func newChatServer(db *sql.DB) *chatServer {
 
 // CreateRoom handles creating a new chat room
 func (s *chatServer) CreateRoom(ctx context.Context, req *pb.CreateRoomRequest) (*pb.RoomResponse, error) {
-	// Authenticate the user
-	userID, _, err := authenticateRequest(ctx)
+	// Get the authenticated user
+	claims, err := middleware.ClaimsFromContext(ctx)
 	if err != nil {
 		return nil, err
 	}
 
 	// Verify the creator ID matches the authenticated user
-	if userID != req.CreatorId {
+	if claims.UserID != req.CreatorId {
 		return nil, status.Errorf(codes.PermissionDenied, "creator ID does not match authenticated user")
 	}
 
@@ -64,14 +64,14 @@ func (s *chatServer) CreateRoom(ctx context.Context, req *pb.CreateRoomRequest)
 
 // GetRooms handles retrieving rooms that a user can access
 func (s *chatServer) GetRooms(ctx context.Context, req *pb.GetRoomsRequest) (*pb.GetRoomsResponse, error) {
-	// Authenticate the user
-	userID, _, err := authenticateRequest(ctx)
+	// Get the authenticated user
+	claims, err := middleware.ClaimsFromContext(ctx)
 	if err != nil {
 		return nil, err
 	}
 
 	// Verify the user ID matches the authenticated user
-	if userID != req.UserId {
+	if claims.UserID != req.UserId {
 		return nil, status.Errorf(codes.PermissionDenied, "user ID does not match authenticated user")
 	}
 
@@ -112,14 +112,14 @@ func (s *chatServer) GetRooms(ctx context.Context, req *pb.GetRoomsRequest) (*pb
 
 // JoinRoom handles a user joining a room
 func (s *chatServer) JoinRoom(ctx context.Context, req *pb.JoinRoomRequest) (*pb.JoinRoomResponse, error) {
-	// Authenticate the user
-	userID, _, err := authenticateRequest(ctx)
+	// Get the authenticated user
+	claims, err := middleware.ClaimsFromContext(ctx)
 	if err != nil {
 		return nil, err
 	}
 
 	// Verify the user ID matches the authenticated user
-	if userID != req.UserId {
+	if claims.UserID != req.UserId {
 		return nil, status.Errorf(codes.PermissionDenied, "user ID does not match authenticated user")
 	}
 
@@ -159,14 +159,14 @@ func (s *chatServer) JoinRoom(ctx context.Context, req *pb.JoinRoomRequest) (*pb
 
 // LeaveRoom handles a user leaving a room
 func (s *chatServer) LeaveRoom(ctx context.Context, req *pb.LeaveRoomRequest) (*pb.LeaveRoomResponse, error) {
-	// Authenticate the user
-	userID, _, err := authenticateRequest(ctx)
+	// Get the authenticated user
+	claims, err := middleware.ClaimsFromContext(ctx)
 	if err != nil {
 		return nil, err
 	}
 
 	// Verify the user ID matches the authenticated user
-	if userID != req.UserId {
+	if claims.UserID != req.UserId {
 		return nil, status.Errorf(codes.PermissionDenied, "user ID does not match authenticated user")
 	}
 
@@ -188,14 +188,14 @@ func (s *chatServer) LeaveRoom(ctx context.Context, req *pb.LeaveRoomRequest) (*
 
 // SendMessage handles sending a new chat message
 func (s *chatServer) SendMessage(ctx context.Context, req *pb.SendMessageRequest) (*pb.SendMessageResponse, error) {
-	// Authenticate the user
-	userID, _, err := authenticateRequest(ctx)
+	// Get the authenticated user
+	claims, err := middleware.ClaimsFromContext(ctx)
 	if err != nil {
 		return nil, err
 	}
 
 	// Verify the sender ID matches the authenticated user
-	if userID != req.SenderId {
+	if claims.UserID != req.SenderId {
 		return nil, status.Errorf(codes.PermissionDenied, "sender ID does not match authenticated user")
 	}
 
@@ -239,14 +239,14 @@ func (s *chatServer) SendMessage(ctx context.Context, req *pb.SendMessageRequest
 
 // GetRoomMessages handles retrieving messages for a room
 func (s *chatServer) GetRoomMessages(ctx context.Context, req *pb.GetRoomMessagesRequest) (*pb.GetRoomMessagesResponse, error) {
-	// Authenticate the user
-	userID, _, err := authenticateRequest(ctx)
+	// Get the authenticated user
+	claims, err := middleware.ClaimsFromContext(ctx)
 	if err != nil {
 		return nil, err
 	}
 
 	// Verify the user ID matches the authenticated user
-	if userID != req.UserId {
+	if claims.UserID != req.UserId {
 		return nil, status.Errorf(codes.PermissionDenied, "user ID does not match authenticated user")
 	}
 
@@ -297,14 +297,14 @@ func (s *chatServer) StreamRoomMessages(req *pb.StreamRoomMessagesRequest, strea
 	// Get context from the stream
 	ctx := stream.Context()
 
-	// Authenticate the user
-	userID, _, err := authenticateRequest(ctx)
+	// Get the authenticated user
+	claims, err := middleware.ClaimsFromContext(ctx)
 	if err != nil {
 		return err
 	}
 
 	// Verify the user ID matches the authenticated user
-	if userID != req.UserId {
+	if claims.UserID != req.UserId {
 		return status.Errorf(codes.PermissionDenied, "user ID does not match authenticated user")
 	}
 
@@ -365,30 +365,3 @@ func (s *chatServer) broadcastMessage(msg *pb.MessageResponse, roomID int64) {
 		}
 	}
 }
-
-// authenticateRequest extracts and validates the JWT token from the request metadata
-func authenticateRequest(ctx context.Context) (int64, string, error) {
-	md, ok := metadata.FromIncomingContext(ctx)
-	if !ok {
-		return 0, "", status.Errorf(codes.Unauthenticated, "metadata is not provided")
-	}
-
-	values := md.Get("authorization")
-	if len(values) == 0 {
-		return 0, "", status.Errorf(codes.Unauthenticated, "authorization token is not provided")
-	}
-
-	// The auth token should be in the format "Bearer <token>"
-	authHeader := values[0]
-	if len(authHeader) < 7 || authHeader[:7] != "Bearer " {
-		return 0, "", status.Errorf(codes.Unauthenticated, "invalid authorization format")
-	}
-
-	token := authHeader[7:]
-	userID, username, err := db.ValidateToken(token)
-	if err != nil {
-		return 0, "", status.Errorf(codes.Unauthenticated, "invalid token: %v", err)
-	}
-
-	return userID, username, nil
-}
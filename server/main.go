@@ -5,6 +5,7 @@ import (
 	"net"
 
 	"grpc-messenger-core/db"
+	"grpc-messenger-core/internal/middleware"
 	pb "grpc-messenger-core/proto"
 
 	"google.golang.org/grpc"
@@ -33,8 +34,20 @@ func main() {
 		log.Fatalf("failed to listen: %v", err)
 	}
 
+	// Create the auth interceptor; registration and login are public
+	publicMethods := append([]string{
+		pb.AuthService_Register_FullMethodName,
+		pb.AuthService_Login_FullMethodName,
+		pb.AuthService_ValidateToken_FullMethodName,
+	}, middleware.ReflectionMethods...)
+	authenticator := middleware.NewAuthenticator(log.Default(), nil, publicMethods...)
+	authenticator.SetValidator(validateToken)
+
 	// Create a new gRPC server
-	s := grpc.NewServer()
+	s := grpc.NewServer(
+		grpc.UnaryInterceptor(authenticator.UnaryInterceptor()),
+		grpc.StreamInterceptor(authenticator.StreamInterceptor()),
+	)
 
 	// No Hello service anymore
 
@@ -54,3 +67,12 @@ func main() {
 		log.Fatalf("failed to serve: %v", err)
 	}
 }
+
+// validateToken validates a token issued by this server's auth service
+func validateToken(token string) (*middleware.Claims, error) {
+	userID, username, err := db.ValidateToken(token)
+	if err != nil {
+		return nil, err
+	}
+	return &middleware.Claims{UserID: userID, Username: username}, nil
+}